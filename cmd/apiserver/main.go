@@ -2,11 +2,18 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
 	"os"
+	"strconv"
 	"yuka/internal/database"
 	"yuka/internal/routers"
+	"yuka/pkg/tls_helper"
 	"yuka/pkg/utils"
 
+	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
 
@@ -14,6 +21,10 @@ import (
 // @version        1.0
 // @description	This is the Yuka API Server.
 // @securityDefinitions.basic BasicAuth
+// @securityDefinitions.apikey ApiToken
+// @in header
+// @name Authorization
+// @description An API token of the user the request acts as, sent as "Bearer <token>"
 
 // @BasePath  		/
 func main() {
@@ -30,6 +41,16 @@ func main() {
 	databasePassword := os.Getenv("DATABASE_PASSWORD")
 	databaseName := os.Getenv("DATABASE_NAME")
 	databasePort := os.Getenv("DATABASE_PORT")
+	publicHost := os.Getenv("TUNNEL_PUBLIC_HOST")
+	tcpPortRange := os.Getenv("TUNNEL_TCP_PORT_RANGE")
+	tunnelTLSConfig, err := tunnelTLSConfig(logger, publicHost)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	tunnelHttps, err := tunnelHttpsOptions()
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
 
 	var db *gorm.DB
 	if environment == "local" {
@@ -42,10 +63,90 @@ func main() {
 		logger.Fatal(err.Error())
 	}
 
-	routerOptions := routers.NewRouterOptions(logger, db)
+	routerOptions := routers.NewRouterOptions(logger, db, publicHost, tcpPortRange, tunnelTLSConfig, tunnelHttps)
 
 	if err := routers.Run(ctx, &routerOptions); err != nil {
 		logger.Fatal(err.Error())
 
 	}
 }
+
+// tunnelTLSConfig secures the tunnel listener, along with the api server's TLS listener agents connect to
+// over wss, with the certificate in TUNNEL_TLS_CERT_FILE and TUNNEL_TLS_KEY_FILE. For development TUNNEL_TLS_SELF_SIGNED=true generates a CA on startup instead, agents
+// pin it with the fingerprint that's logged. When TUNNEL_TLS_CLIENT_CA_FILE is set agents must also present
+// a certificate signed by it. Nil is returned when TLS isn't configured.
+func tunnelTLSConfig(logger *zap.Logger, publicHost string) (*tls.Config, error) {
+	certFile := os.Getenv("TUNNEL_TLS_CERT_FILE")
+	keyFile := os.Getenv("TUNNEL_TLS_KEY_FILE")
+	clientCAFile := os.Getenv("TUNNEL_TLS_CLIENT_CA_FILE")
+	if certFile != "" || keyFile != "" {
+		return tls_helper.NewServerConfig(tls_helper.ServerOptions{
+			CertFile:     certFile,
+			KeyFile:      keyFile,
+			ClientCAFile: clientCAFile,
+		})
+	}
+	if os.Getenv("TUNNEL_TLS_SELF_SIGNED") != "true" {
+		return nil, nil
+	}
+
+	ca, err := tls_helper.NewCA("yuka development CA")
+	if err != nil {
+		return nil, err
+	}
+	hostnames := []string{"localhost", "127.0.0.1", "::1"}
+	if host, _, err := net.SplitHostPort(publicHost); err == nil {
+		hostnames = append(hostnames, host)
+	} else if publicHost != "" {
+		hostnames = append(hostnames, publicHost)
+	}
+	certificate, err := ca.IssueServerCertificate(hostnames...)
+	if err != nil {
+		return nil, err
+	}
+	logger.Sugar().Infof("Generated a self-signed CA for the tunnel listener, connect with --server-fingerprint %s", tls_helper.Fingerprint(ca.Certificate))
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientCAFile != "" {
+		if err := tls_helper.RequireClientCertificates(config, clientCAFile); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// tunnelHttpsOptions serves tunnels over HTTPS on TUNNEL_HTTPS_PORT with certificates from the ACME CA at
+// ACME_DIRECTORY_URL, Let's Encrypt by default. ACME_DNS_HOOK is a script creating the DNS records for DNS-01
+// challenges, with it every subdomain of the public host shares a wildcard certificate. ACME_CA_FILE is only
+// needed for a CA that isn't publicly trusted. Nil is returned when HTTPS isn't configured.
+func tunnelHttpsOptions() (*routers.TunnelHttpsOptions, error) {
+	port := os.Getenv("TUNNEL_HTTPS_PORT")
+	if port == "" {
+		return nil, nil
+	}
+	httpsPort, err := strconv.Atoi(port)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TUNNEL_HTTPS_PORT %q", port)
+	}
+	options := &routers.TunnelHttpsOptions{
+		Port:         httpsPort,
+		DirectoryURL: os.Getenv("ACME_DIRECTORY_URL"),
+		Email:        os.Getenv("ACME_EMAIL"),
+	}
+	if hook := os.Getenv("ACME_DNS_HOOK"); hook != "" {
+		options.DNSProvider = tls_helper.NewExecDNSProvider(hook)
+	}
+	if caFile := os.Getenv("ACME_CA_FILE"); caFile != "" {
+		pool, err := tls_helper.LoadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		options.HTTPClient = &http.Client{
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}},
+		}
+	}
+	return options, nil
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"log"
+	"yuka/internal/client"
 	"yuka/pkg/utils"
 
 	"github.com/go-playground/validator/v10"
@@ -33,6 +34,15 @@ var clientCmd = &cobra.Command{
 	},
 }
 
+// daemonPaths returns the paths of the files used by the agent in detached mode
+func daemonPaths() *client.DaemonPaths {
+	paths, err := client.DefaultDaemonPaths()
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+	return paths
+}
+
 func SubCommand() *cobra.Command {
 	return clientCmd
 }
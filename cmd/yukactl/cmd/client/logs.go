@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"yuka/internal/client"
+	"yuka/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+type logsOptions struct {
+	Lines  int  `flag:"lines" validate:"min=0"`
+	Follow bool `flag:"follow"`
+}
+
+var _logsOptions logsOptions
+
+// logsCmd represents the logs command
+var logsCmd = &cobra.Command{
+	Use:   "logs",
+	Short: "Prints the logs of yukactl running in detached mode",
+	Long: `Prints the logs of the agent started with "yukactl client start --detach".
+Run "yukactl client logs --help" for more information.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := utils.ValidateAndUnmarshal(cmd, &_logsOptions, validationFns); err != nil {
+			log.Fatalln(err.Error())
+		}
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+
+		err := client.NewControlClient(daemonPaths()).Logs(ctx, os.Stdout, _logsOptions.Lines, _logsOptions.Follow)
+		if errors.Is(err, client.ErrDaemonNotRunning) {
+			fmt.Println("yukactl is not running in detached mode")
+			os.Exit(1)
+		}
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+	},
+}
+
+func init() {
+	logsCmd.Flags().IntP("lines", "n", 100, "Number of lines from the end of the logs to print")
+	logsCmd.Flags().BoolP("follow", "f", false, "Keep printing new lines as they're logged")
+	clientCmd.AddCommand(logsCmd)
+}
@@ -2,19 +2,28 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
+	"yuka/cmd/yukactl/cmd/tunnel"
 	"yuka/internal/client"
+	"yuka/pkg/streaming_connection"
 	"yuka/pkg/utils"
 
 	"github.com/spf13/cobra"
 )
 
 type startOptions struct {
-	RegisteredHostname string `flag:"registered-hostname" validate:"required"`
+	RegisteredHostname    string `flag:"registered-hostname" validate:"omitempty,hostname_rfc1123"`
+	ForwardAddress        string `flag:"forward-address" validate:"required,hostname_port"`
+	LoadBalancingStrategy string `flag:"load-balancing" validate:"omitempty,oneof=round-robin least-in-flight consistent-hash"`
+	Detach                bool   `flag:"detach"`
+	// Daemon is set on the agent started in the background by --detach
+	Daemon bool `flag:"daemon"`
 }
 
 var _startOptions startOptions
@@ -37,9 +46,31 @@ Run "yukactl client start --help" for more information.`,
 			logger.Fatal(err.Error())
 		}
 
-		apiserverAddress, _ := cmd.Flags().GetString("apiserver-address")
+		if _startOptions.Detach && !_startOptions.Daemon {
+			cancel()
+			pid, err := client.Detach(logger, daemonPaths(), daemonArgs(os.Args[1:]))
+			if err != nil {
+				log.Fatalln(err.Error())
+			}
+			fmt.Printf("Started yukactl in detached mode with pid %d, run \"yukactl client status\" to check on it\n", pid)
+			return
+		}
+
+		yukaClient, err := tunnel.NewTunnelClient(cmd, logger)
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
 
-		client := client.NewClient(apiserverAddress, logger, _startOptions.RegisteredHostname)
+		tunnels := []client.TunnelSpec{
+			{
+				TunnelRequest: streaming_connection.TunnelRequest{
+					Protocol:              streaming_connection.TunnelProtocolHttp,
+					Hostname:              _startOptions.RegisteredHostname,
+					LoadBalancingStrategy: _startOptions.LoadBalancingStrategy,
+				},
+				Handler: client.NewTcpForwarder(logger, _startOptions.ForwardAddress),
+			},
+		}
 
 		// Set up a signal channel to capture SIGTERM
 		sigCh := make(chan os.Signal, 1)
@@ -56,19 +87,42 @@ Run "yukactl client start --help" for more information.`,
 			logger.Sugar().Infof("Received signal: %v", sig)
 
 			// Perform cleanup or any necessary actions
-			if err := client.Cleanup(context.TODO()); err != nil {
+			if err := yukaClient.Cleanup(context.TODO()); err != nil {
 				logger.Sugar().Errorf("An error occurred in cleanup %v", err)
 			}
 			cancel() // Cancel the context
 		}()
 
-		if err := client.Start(ctx); err != nil {
+		if _startOptions.Daemon {
+			err = yukaClient.StartDetached(ctx, cancel, daemonPaths(), tunnels)
+		} else {
+			err = yukaClient.Start(ctx, tunnels, nil)
+		}
+		if err != nil {
 			logger.Fatal(err.Error())
 		}
 	},
 }
 
 func init() {
-	startCmd.PersistentFlags().StringP("registered-hostname", "r", "localhost:8085", "Hostname that we can access the host publicly")
+	startCmd.PersistentFlags().StringP("registered-hostname", "r", "", "Hostname that we can access the host publicly, a random one is assigned when not set")
+	startCmd.PersistentFlags().StringP("forward-address", "f", "localhost:5432", "Address that connections are forwarded to")
+	startCmd.PersistentFlags().String("load-balancing", "", "How requests are balanced when other agents register the same hostname, one of round-robin, least-in-flight or consistent-hash")
+	startCmd.PersistentFlags().Bool("detach", false, "Run in the background, use \"yukactl client stop\" to stop it")
+	startCmd.PersistentFlags().Bool("daemon", false, "Run as the agent started by --detach")
+	startCmd.PersistentFlags().MarkHidden("daemon")
 	clientCmd.AddCommand(startCmd)
 }
+
+// daemonArgs returns the arguments to start the detached agent with, which are the same as the ones
+// start was called with other than --detach
+func daemonArgs(args []string) []string {
+	daemonArgs := make([]string, 0, len(args)+1)
+	for _, arg := range args {
+		if arg == "--detach" || strings.HasPrefix(arg, "--detach=") {
+			continue
+		}
+		daemonArgs = append(daemonArgs, arg)
+	}
+	return append(daemonArgs, "--daemon")
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"yuka/internal/client"
+
+	"github.com/spf13/cobra"
+)
+
+// statusCmd represents the status command
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Shows the status of yukactl running in detached mode",
+	Long: `Shows the state, latency and tunnels of the agent started with "yukactl client start --detach".
+Run "yukactl client status --help" for more information.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		status, err := client.NewControlClient(daemonPaths()).Status(context.Background())
+		if errors.Is(err, client.ErrDaemonNotRunning) {
+			fmt.Println("yukactl is not running in detached mode")
+			os.Exit(1)
+		}
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "Pid:\t%d\n", status.Pid)
+		fmt.Fprintf(w, "State:\t%s\n", status.State)
+		fmt.Fprintf(w, "Uptime:\t%s\n", time.Since(status.StartedAt).Round(time.Second))
+		fmt.Fprintf(w, "Latency:\t%dms\n", status.LatencyMs)
+		for _, tunnel := range status.Tunnels {
+			fmt.Fprintf(w, "Tunnel:\t%s\n", tunnel.PublicURL)
+		}
+		w.Flush()
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(statusCmd)
+}
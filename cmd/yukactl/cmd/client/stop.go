@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"yuka/internal/client"
+
+	"github.com/spf13/cobra"
+)
+
+// stopCmd represents the stop command
+var stopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stops yukactl running in detached mode",
+	Long: `Stops the agent started with "yukactl client start --detach" once its in-flight requests have finished.
+Run "yukactl client stop --help" for more information.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		pid, err := client.StopDaemon(context.Background(), daemonPaths())
+		if errors.Is(err, client.ErrDaemonNotRunning) {
+			fmt.Println("yukactl is not running in detached mode")
+			return
+		}
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+		fmt.Printf("Stopped yukactl with pid %d\n", pid)
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(stopCmd)
+}
@@ -2,12 +2,20 @@ package cmd
 
 import (
 	"yuka/cmd/yukactl/cmd/client"
+	"yuka/cmd/yukactl/cmd/org"
+	"yuka/cmd/yukactl/cmd/tunnel"
 
 	"github.com/spf13/cobra"
 )
 
 var subcommands = []*cobra.Command{
 	client.SubCommand(),
+	tunnel.HttpCommand(),
+	tunnel.TcpCommand(),
+	tunnel.UdpCommand(),
+	tunnel.TlsCommand(),
+	tunnel.StartCommand(),
+	org.SubCommand(),
 }
 
 func init() {
@@ -0,0 +1,31 @@
+package config
+
+import (
+	"yuka/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+var configurator *utils.Configurator
+
+// Load reads the config for cmd. Flags that aren't set are read from YUKA_ prefixed environment variables
+// or $HOME/.yuka/config.yaml, i.e --authtoken can be set with YUKA_AUTHTOKEN. The config is only read
+// once, later calls return the same Configurator.
+func Load(cmd *cobra.Command) (*utils.Configurator, error) {
+	if configurator != nil {
+		return configurator, nil
+	}
+
+	c, err := utils.NewConfigurator(&utils.ConfiguratorProps{
+		DefaultFilename: "config",
+		ConfigType:      "yaml",
+		ConfigPaths:     []string{"$HOME/.yuka"},
+		EnvPrefix:       "YUKA",
+		Cmd:             cmd,
+	})
+	if err != nil {
+		return nil, err
+	}
+	configurator = c
+	return configurator, nil
+}
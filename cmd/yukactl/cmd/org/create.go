@@ -0,0 +1,53 @@
+package org
+
+import (
+	"fmt"
+	"log"
+
+	"yuka/internal/api/api_clients/organizations"
+	"yuka/internal/api/api_models"
+	"yuka/pkg/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/spf13/cobra"
+)
+
+type createOptions struct {
+	Description string `flag:"description"`
+}
+
+var _createOptions createOptions
+
+var validationFns = map[string]func(validator.FieldLevel) bool{}
+
+// createCmd represents the create command
+var createCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Creates an organization",
+	Long: `Creates an organization owned by the user the API token belongs to.
+Run "yukactl org create --help" for more information.`,
+	Example: `  yukactl org create acme --description "Acme Corporation"`,
+	Args:    cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := utils.ValidateAndUnmarshal(cmd, &_createOptions, validationFns); err != nil {
+			log.Fatalln(err.Error())
+		}
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		c := newClient(cmd)
+		params := organizations.NewCreateOrganizationParams().WithCreate(&api_models.HandlersCreateOrganizationInput{
+			Name:        &args[0],
+			Description: _createOptions.Description,
+		})
+		res, err := c.ApiClient.Organizations.CreateOrganization(params, c.ApiAuth())
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+		fmt.Printf("Created organization %s with id %s\n", res.Payload.Name, res.Payload.ID)
+	},
+}
+
+func init() {
+	createCmd.Flags().String("description", "", "Description of the organization.")
+	orgCmd.AddCommand(createCmd)
+}
@@ -0,0 +1,67 @@
+package org
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"yuka/internal/api/api_clients/invitations"
+	"yuka/internal/api/api_models"
+	"yuka/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+type inviteOptions struct {
+	Organization string `flag:"org" validate:"required,uuid"`
+	Role         string `flag:"role" validate:"oneof=owner admin member"`
+	ExpiresIn    string `flag:"expires-in"`
+}
+
+var _inviteOptions inviteOptions
+
+// inviteCmd represents the invite command
+var inviteCmd = &cobra.Command{
+	Use:   "invite <email>",
+	Short: "Invites someone to an organization",
+	Long: `Creates an invitation to an organization and prints its token, which the invited user joins with
+"yukactl org join <token>". The token can only be used once and is only shown now.
+Run "yukactl org invite --help" for more information.`,
+	Example: `  yukactl org invite jane@example.com --org aa22666c-0f57-45cb-a449-16efecc04f2e
+  yukactl org invite jane@example.com --org aa22666c-0f57-45cb-a449-16efecc04f2e --role admin --expires-in 24h`,
+	Args: cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := utils.ValidateAndUnmarshal(cmd, &_inviteOptions, validationFns); err != nil {
+			log.Fatalln(err.Error())
+		}
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &api_models.HandlersCreateInvitationInput{
+			Email: &args[0],
+			Role:  _inviteOptions.Role,
+		}
+		if _inviteOptions.ExpiresIn != "" {
+			expiresIn, err := time.ParseDuration(_inviteOptions.ExpiresIn)
+			if err != nil {
+				log.Fatalln(err.Error())
+			}
+			input.ExpiresAt = time.Now().Add(expiresIn).Format(time.RFC3339)
+		}
+
+		c := newClient(cmd)
+		params := invitations.NewCreateInvitationParams().WithID(_inviteOptions.Organization).WithCreate(input)
+		res, err := c.ApiClient.Invitations.CreateInvitation(params, c.ApiAuth())
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+		fmt.Printf("Invited %s as %s until %s, they can join with:\n\n  yukactl org join %s\n",
+			res.Payload.Email, res.Payload.Role, res.Payload.ExpiresAt, res.Payload.Token)
+	},
+}
+
+func init() {
+	inviteCmd.Flags().String("org", "", "ID of the organization to invite to.")
+	inviteCmd.Flags().String("role", "member", "Role given to the invited user, one of owner, admin or member.")
+	inviteCmd.Flags().String("expires-in", "", "How long the invitation can be accepted for, i.e 24h. Invitations expire after a week by default.")
+	orgCmd.AddCommand(inviteCmd)
+}
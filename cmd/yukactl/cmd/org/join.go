@@ -0,0 +1,35 @@
+package org
+
+import (
+	"fmt"
+	"log"
+
+	"yuka/internal/api/api_clients/invitations"
+	"yuka/internal/api/api_models"
+
+	"github.com/spf13/cobra"
+)
+
+// joinCmd represents the join command
+var joinCmd = &cobra.Command{
+	Use:   "join <token>",
+	Short: "Joins an organization with an invitation",
+	Long: `Accepts the invitation with the token printed by "yukactl org invite", joining its organization.
+Run "yukactl org join --help" for more information.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		c := newClient(cmd)
+		params := invitations.NewAcceptInvitationParams().WithAccept(&api_models.HandlersAcceptInvitationInput{
+			Token: &args[0],
+		})
+		res, err := c.ApiClient.Invitations.AcceptInvitation(params, c.ApiAuth())
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+		fmt.Printf("Joined organization %s with id %s\n", res.Payload.Name, res.Payload.ID)
+	},
+}
+
+func init() {
+	orgCmd.AddCommand(joinCmd)
+}
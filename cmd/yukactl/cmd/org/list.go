@@ -0,0 +1,39 @@
+package org
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"yuka/internal/api/api_clients/organizations"
+
+	"github.com/spf13/cobra"
+)
+
+// listCmd represents the list command
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists your organizations",
+	Long: `Lists the organizations the user the API token belongs to is a member of.
+Run "yukactl org list --help" for more information.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		c := newClient(cmd)
+		res, err := c.ApiClient.Organizations.GetOrganizations(organizations.NewGetOrganizationsParams(), c.ApiAuth())
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tNAME\tDESCRIPTION")
+		for _, organization := range res.Payload {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", organization.ID, organization.Name, organization.Description)
+		}
+		w.Flush()
+	},
+}
+
+func init() {
+	orgCmd.AddCommand(listCmd)
+}
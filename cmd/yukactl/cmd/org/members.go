@@ -0,0 +1,40 @@
+package org
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"yuka/internal/api/api_clients/organizations"
+
+	"github.com/spf13/cobra"
+)
+
+// membersCmd represents the members command
+var membersCmd = &cobra.Command{
+	Use:   "members <organization id>",
+	Short: "Lists the members of an organization",
+	Long: `Lists the members of an organization and their roles.
+Run "yukactl org members --help" for more information.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		c := newClient(cmd)
+		params := organizations.NewGetOrganizationMembersParams().WithID(args[0])
+		res, err := c.ApiClient.Organizations.GetOrganizationMembers(params, c.ApiAuth())
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "USER ID\tUSERNAME\tROLE\tJOINED")
+		for _, member := range res.Payload {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", member.UserID, member.Username, member.Role, member.JoinedAt)
+		}
+		w.Flush()
+	},
+}
+
+func init() {
+	orgCmd.AddCommand(membersCmd)
+}
@@ -0,0 +1,43 @@
+package org
+
+import (
+	"log"
+
+	"yuka/internal/client"
+	"yuka/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// orgCmd represents the org command
+var orgCmd = &cobra.Command{
+	Use:     "org",
+	Aliases: []string{"organization"},
+	Short:   "Manages organizations",
+	Long: `Creates organizations and manages their members, acting as the user the API token set with --authtoken belongs to.
+Run "yukactl org --help" for more information.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		err := cmd.Help()
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+	},
+}
+
+// newClient returns a client for the api server authenticated with the --authtoken API token
+func newClient(cmd *cobra.Command) *client.Client {
+	logger, err := utils.GetLogger()
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+	apiserverAddress, _ := cmd.Flags().GetString("apiserver-address")
+	authToken, _ := cmd.Flags().GetString("authtoken")
+	if authToken == "" {
+		log.Fatalln("an API token is required, set one with --authtoken")
+	}
+	return client.NewClient(apiserverAddress, logger, "", authToken, client.TransportTcp, nil)
+}
+
+func SubCommand() *cobra.Command {
+	return orgCmd
+}
@@ -5,6 +5,8 @@ import (
 	"os"
 
 	"log"
+	"yuka/cmd/yukactl/cmd/config"
+	"yuka/internal/client"
 	"yuka/pkg/utils"
 
 	"github.com/spf13/cobra"
@@ -41,6 +43,13 @@ var rootCmd = &cobra.Command{
 	Short: "A useful client for dealing with a useful network!",
 	Long: `TBA.
 	Run "yukactl help" for more information.`,
+	// Flags that aren't set are read from YUKA_ prefixed environment variables or $HOME/.yuka/config.yaml,
+	// i.e --authtoken can be set with YUKA_AUTHTOKEN
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		if _, err := config.Load(cmd); err != nil {
+			log.Fatalln(err.Error())
+		}
+	},
 	PreRun: func(cmd *cobra.Command, args []string) {
 		if err := utils.ValidateAndUnmarshal(cmd, &_rootOptions, validationFns); err != nil {
 			log.Fatalln(err.Error())
@@ -60,6 +69,15 @@ var rootCmd = &cobra.Command{
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
 	rootCmd.PersistentFlags().StringP("apiserver-address", "a", "localhost:8080", "Address of the yuka api server.")
+	rootCmd.PersistentFlags().StringP("tunnel-address", "t", "localhost:8085", "Address of the yuka tunnel server that agents connect to.")
+	rootCmd.PersistentFlags().String("authtoken", "", "API token used to authenticate with the yuka server, users get their first one when they are created.")
+	rootCmd.PersistentFlags().String("transport", string(client.TransportAuto), "How agents connect to the yuka server, one of tcp, quic, ws or auto. ws connects over a websocket to the api server, going through HTTPS_PROXY if it's set. auto tries quic, falling back to tcp when UDP is blocked and then to ws.")
+	rootCmd.PersistentFlags().String("websocket-address", "", "Address of the yuka api server listener the ws transport connects to, defaults to --apiserver-address. With TLS enabled it must be the api server's TLS listener, on port 8443 by default.")
+	rootCmd.PersistentFlags().Bool("tls", false, "Connect to the tunnel server over TLS, verifying it with the system's CAs. Implied by the other TLS flags.")
+	rootCmd.PersistentFlags().String("server-ca", "", "PEM file of the CA the tunnel server's certificate must be signed by.")
+	rootCmd.PersistentFlags().String("server-fingerprint", "", "SHA-256 fingerprint of the tunnel server's certificate or its CA. Without --server-ca only the fingerprint is checked, which is how self-signed servers are trusted.")
+	rootCmd.PersistentFlags().String("client-cert", "", "PEM file of the certificate presented to tunnel servers that require mutual TLS.")
+	rootCmd.PersistentFlags().String("client-key", "", "PEM file of the key for --client-cert.")
 	if err := rootCmd.Execute(); err != nil {
 		os.Exit(1)
 	}
@@ -0,0 +1,101 @@
+package tunnel
+
+import (
+	"log"
+	"net"
+	"net/url"
+	"strconv"
+
+	"yuka/internal/client"
+	"yuka/pkg/streaming_connection"
+	"yuka/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+type httpOptions struct {
+	Subdomain             string `flag:"subdomain" validate:"omitempty,hostname_rfc1123"`
+	HostHeader            string `flag:"host-header"`
+	UpstreamHost          string `flag:"upstream-host" validate:"required,hostname_rfc1123|ip"`
+	UpstreamScheme        string `flag:"upstream-scheme" validate:"oneof=http https"`
+	LoadBalancingStrategy string `flag:"load-balancing" validate:"omitempty,oneof=round-robin least-in-flight consistent-hash"`
+	Inspect               bool   `flag:"inspect"`
+	InspectAddress        string `flag:"inspect-address" validate:"required,isAddress"`
+}
+
+var _httpOptions httpOptions
+
+// httpCmd represents the http command
+var httpCmd = &cobra.Command{
+	Use:   "http <port>",
+	Short: "Exposes a local HTTP service",
+	Long: `Exposes the HTTP service listening on the given local port through a public URL.
+Run "yukactl http --help" for more information.`,
+	Example: `  yukactl http 3000
+  yukactl http 3000 --subdomain foo --host-header rewrite
+  yukactl http 8443 --upstream-scheme https`,
+	Args: cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := utils.ValidateAndUnmarshal(cmd, &_httpOptions, validationFns); err != nil {
+			log.Fatalln(err.Error())
+		}
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		logger, err := utils.GetLogger()
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+		port, err := parsePort(args[0])
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+
+		yukaClient, err := NewTunnelClient(cmd, logger)
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+
+		ctx := signalContext(logger)
+		proxyOptions := client.HttpProxyOptions{HostHeader: _httpOptions.HostHeader}
+		if _httpOptions.Inspect {
+			proxyOptions.Inspector = startInspector(ctx, logger, _httpOptions.InspectAddress)
+		}
+
+		upstream := &url.URL{
+			Scheme: _httpOptions.UpstreamScheme,
+			Host:   net.JoinHostPort(_httpOptions.UpstreamHost, strconv.Itoa(port)),
+		}
+		tunnels := []client.TunnelSpec{
+			{
+				TunnelRequest: streaming_connection.TunnelRequest{
+					Protocol:              streaming_connection.TunnelProtocolHttp,
+					Hostname:              _httpOptions.Subdomain,
+					LoadBalancingStrategy: _httpOptions.LoadBalancingStrategy,
+				},
+				Handler: client.NewHttpProxy(logger, upstream, proxyOptions),
+			},
+		}
+
+		err = yukaClient.Start(ctx, tunnels, func(assignments []streaming_connection.TunnelAssignment) {
+			for _, assignment := range assignments {
+				printForwarding(assignment.PublicURL, upstream.String())
+			}
+		})
+		if err != nil {
+			log.Fatalf("error running tunnel: %v", err)
+		}
+	},
+}
+
+func HttpCommand() *cobra.Command {
+	return httpCmd
+}
+
+func init() {
+	httpCmd.Flags().StringP("subdomain", "s", "", "Subdomain to serve the tunnel on, a random one is assigned when not set")
+	httpCmd.Flags().String("host-header", "", `Host header sent to the local service, "rewrite" uses the upstream address and any other value is sent as is. The public host is kept when not set`)
+	httpCmd.Flags().String("upstream-host", "localhost", "Host the local service is listening on")
+	httpCmd.Flags().String("upstream-scheme", "http", "Scheme used to reach the local service, one of http or https")
+	httpCmd.Flags().String("load-balancing", "", "How requests are balanced when other agents register the same hostname, one of round-robin, least-in-flight or consistent-hash")
+	addInspectorFlags(httpCmd)
+}
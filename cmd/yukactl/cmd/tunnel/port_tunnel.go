@@ -0,0 +1,123 @@
+package tunnel
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+
+	"yuka/internal/client"
+	"yuka/pkg/streaming_connection"
+	"yuka/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// portTunnelOptions are the flags of the tcp, udp and tls commands, each only has the flags for its protocol
+type portTunnelOptions struct {
+	RemotePort            int    `flag:"remote-port" validate:"min=0,max=65535"`
+	Hostname              string `flag:"hostname" validate:"omitempty,hostname_rfc1123"`
+	UpstreamHost          string `flag:"upstream-host" validate:"required,hostname_rfc1123|ip"`
+	LoadBalancingStrategy string `flag:"load-balancing" validate:"omitempty,oneof=round-robin least-in-flight consistent-hash"`
+}
+
+// newPortTunnelCommand returns the command exposing the local service listening on the port given as its
+// argument through a tunnel of protocol. TCP and UDP tunnels are served on a public port allocated by the
+// server while TLS tunnels are routed by hostname.
+func newPortTunnelCommand(protocol string, short string, long string) *cobra.Command {
+	var options portTunnelOptions
+	cmd := &cobra.Command{
+		Use:   protocol + " <port>",
+		Short: short,
+		Long:  fmt.Sprintf("%s\nRun \"yukactl %s --help\" for more information.", long, protocol),
+		Args:  cobra.ExactArgs(1),
+		PreRun: func(cmd *cobra.Command, args []string) {
+			if err := utils.ValidateAndUnmarshal(cmd, &options, validationFns); err != nil {
+				log.Fatalln(err.Error())
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			logger, err := utils.GetLogger()
+			if err != nil {
+				log.Fatalln(err.Error())
+			}
+			port, err := parsePort(args[0])
+			if err != nil {
+				log.Fatalln(err.Error())
+			}
+
+			yukaClient, err := NewTunnelClient(cmd, logger)
+			if err != nil {
+				log.Fatalln(err.Error())
+			}
+
+			upstream := net.JoinHostPort(options.UpstreamHost, strconv.Itoa(port))
+			var handler client.StreamHandler = client.NewTcpForwarder(logger, upstream)
+			if protocol == streaming_connection.TunnelProtocolUdp {
+				handler = client.NewUdpForwarder(logger, upstream)
+			}
+			tunnels := []client.TunnelSpec{
+				{
+					TunnelRequest: streaming_connection.TunnelRequest{
+						Protocol:              protocol,
+						Hostname:              options.Hostname,
+						RemotePort:            options.RemotePort,
+						LoadBalancingStrategy: options.LoadBalancingStrategy,
+					},
+					Handler: handler,
+				},
+			}
+
+			err = yukaClient.Start(signalContext(logger), tunnels, func(assignments []streaming_connection.TunnelAssignment) {
+				for _, assignment := range assignments {
+					printForwarding(assignment.PublicURL, upstream)
+				}
+			})
+			if err != nil {
+				log.Fatalf("error running tunnel: %v", err)
+			}
+		},
+	}
+
+	balanced, registered := "connections", "port"
+	if protocol == streaming_connection.TunnelProtocolTls {
+		cmd.Flags().String("hostname", "", "Hostname to serve the tunnel on, either a subdomain of the server or a custom domain pointed at it. A random subdomain is assigned when not set")
+		registered = "hostname"
+	} else {
+		cmd.Flags().Int("remote-port", 0, "Public port to serve the tunnel on, one is allocated by the server when not set")
+	}
+	if protocol == streaming_connection.TunnelProtocolUdp {
+		balanced = "remote peers"
+	}
+	cmd.Flags().String("upstream-host", "localhost", "Host the local service is listening on")
+	cmd.Flags().String("load-balancing", "", fmt.Sprintf("How %s are balanced when other agents register the same %s, one of round-robin, least-in-flight or consistent-hash", balanced, registered))
+	return cmd
+}
+
+func TcpCommand() *cobra.Command {
+	cmd := newPortTunnelCommand(streaming_connection.TunnelProtocolTcp, "Exposes a local TCP service",
+		"Exposes the TCP service listening on the given local port through a public port allocated by the server.")
+	cmd.Example = `  yukactl tcp 5432
+  yukactl tcp 22 --remote-port 20022`
+	return cmd
+}
+
+func UdpCommand() *cobra.Command {
+	cmd := newPortTunnelCommand(streaming_connection.TunnelProtocolUdp, "Exposes a local UDP service",
+		`Exposes the UDP service listening on the given local port through a public port allocated by the server.
+Each remote peer is given a socket of its own to the local service until it goes idle, so replies reach the
+peer that sent the datagram they answer.`)
+	cmd.Example = `  yukactl udp 53
+  yukactl udp 27015 --remote-port 20015`
+	return cmd
+}
+
+func TlsCommand() *cobra.Command {
+	cmd := newPortTunnelCommand(streaming_connection.TunnelProtocolTls, "Exposes a local service that terminates its own TLS",
+		`Exposes the TLS service listening on the given local port. Connections are routed by the server name they
+ask for and passed through without being decrypted, so the local service presents its own certificate and
+can require client certificates.`)
+	cmd.Example = `  yukactl tls 8443 --hostname foo.example.com
+  yukactl tls 8443 --hostname foo`
+	return cmd
+}
@@ -0,0 +1,101 @@
+package tunnel
+
+import (
+	"fmt"
+	"log"
+
+	"yuka/cmd/yukactl/cmd/config"
+	"yuka/internal/client"
+	"yuka/pkg/streaming_connection"
+	"yuka/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+type startOptions struct {
+	All            bool   `flag:"all"`
+	Inspect        bool   `flag:"inspect"`
+	InspectAddress string `flag:"inspect-address" validate:"required,isAddress"`
+}
+
+var _startOptions startOptions
+
+// startCmd represents the start command
+var startCmd = &cobra.Command{
+	Use:   "start [<name>...]",
+	Short: "Starts tunnels defined in the config file",
+	Long: `Starts the tunnels with the given names from the tunnels section of the config file over a single connection.
+Run "yukactl start --help" for more information.`,
+	Example: `  yukactl start --all
+  yukactl start web api`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := utils.ValidateAndUnmarshal(cmd, &_startOptions, validationFns); err != nil {
+			log.Fatalln(err.Error())
+		}
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		all, _ := cmd.Flags().GetBool("all")
+		if all && len(args) > 0 {
+			return fmt.Errorf("either give the names of the tunnels to start or --all, not both")
+		}
+		if !all && len(args) == 0 {
+			return fmt.Errorf("give the names of the tunnels to start or --all to start every tunnel")
+		}
+		return nil
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		logger, err := utils.GetLogger()
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+
+		configurator, err := config.Load(cmd)
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+		tunnelsConfig, err := client.LoadTunnelsConfig(configurator)
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+		definitions, err := tunnelsConfig.Select(args)
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+
+		yukaClient, err := NewTunnelClient(cmd, logger)
+		if err != nil {
+			log.Fatalln(err.Error())
+		}
+
+		ctx := signalContext(logger)
+		var inspector *client.Inspector
+		if _startOptions.Inspect {
+			inspector = startInspector(ctx, logger, _startOptions.InspectAddress)
+		}
+
+		tunnels := make([]client.TunnelSpec, len(definitions))
+		targets := make(map[string]string, len(definitions))
+		for i, definition := range definitions {
+			tunnels[i] = definition.Spec(logger, inspector)
+			targets[definition.Name] = definition.Target()
+		}
+
+		err = yukaClient.Start(ctx, tunnels, func(assignments []streaming_connection.TunnelAssignment) {
+			for _, assignment := range assignments {
+				printForwarding(assignment.PublicURL, fmt.Sprintf("%s (%s)", targets[assignment.Name], assignment.Name))
+			}
+		})
+		if err != nil {
+			log.Fatalf("error running tunnels: %v", err)
+		}
+	},
+}
+
+func StartCommand() *cobra.Command {
+	return startCmd
+}
+
+func init() {
+	startCmd.Flags().Bool("all", false, "Start every tunnel defined in the config file")
+	addInspectorFlags(startCmd)
+}
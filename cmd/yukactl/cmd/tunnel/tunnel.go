@@ -0,0 +1,97 @@
+package tunnel
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"yuka/internal/client"
+	"yuka/pkg/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var validationFns = map[string]func(validator.FieldLevel) bool{}
+
+// parsePort parses the local port given as the argument to a tunnel command
+func parsePort(arg string) (int, error) {
+	port, err := strconv.Atoi(arg)
+	if err != nil {
+		return 0, utils.ErrInvalidPort
+	}
+	if port < 1 || port > 65535 {
+		return 0, utils.ErrPortOutOfRange
+	}
+	return port, nil
+}
+
+// NewTunnelClient builds the client connecting to the server from the connection flags of cmd
+func NewTunnelClient(cmd *cobra.Command, logger *zap.Logger) (*client.Client, error) {
+	apiserverAddress, _ := cmd.Flags().GetString("apiserver-address")
+	tunnelAddress, _ := cmd.Flags().GetString("tunnel-address")
+	authToken, _ := cmd.Flags().GetString("authtoken")
+	transportFlag, _ := cmd.Flags().GetString("transport")
+	transport, err := client.ParseTransport(transportFlag)
+	if err != nil {
+		return nil, err
+	}
+	var tlsOptions client.TLSOptions
+	if err := utils.UnmarshalFlags(cmd, &tlsOptions); err != nil {
+		return nil, err
+	}
+	tunnelTLSConfig, err := tlsOptions.Config()
+	if err != nil {
+		return nil, err
+	}
+	c := client.NewClient(apiserverAddress, logger, tunnelAddress, authToken, transport, tunnelTLSConfig)
+	c.WebSocketAddress, _ = cmd.Flags().GetString("websocket-address")
+	return c, nil
+}
+
+// signalContext returns a context that is cancelled once the process is interrupted or terminated
+func signalContext(logger *zap.Logger) context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Set up a signal channel to capture SIGTERM
+	sigCh := make(chan os.Signal, 1)
+	// interrupt signal sent from terminal
+	signal.Notify(sigCh, os.Interrupt)
+	// sigterm signal sent from kubernetes
+	signal.Notify(sigCh, syscall.SIGTERM)
+	signal.Notify(sigCh, syscall.SIGINT)
+
+	go func() {
+		sig := <-sigCh
+		logger.Sugar().Infof("Received signal: %v", sig)
+		cancel()
+	}()
+	return ctx
+}
+
+// addInspectorFlags adds the flags configuring the inspector to cmd
+func addInspectorFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("inspect", false, "Capture requests so they can be inspected and replayed from a local web UI")
+	cmd.Flags().String("inspect-address", client.DefaultInspectorAddress, "Loopback address the inspector UI is served on")
+}
+
+// startInspector serves the inspector on address until ctx is cancelled. Failing to serve it isn't fatal
+// as the tunnels still work without it.
+func startInspector(ctx context.Context, logger *zap.Logger, address string) *client.Inspector {
+	inspector := client.NewInspector(logger, client.DefaultInspectorCapacity, client.DefaultInspectorMaxBodySize)
+	go func() {
+		if err := inspector.Listen(ctx, address); err != nil {
+			logger.Sugar().Warnf("Unable to serve the inspector, requests won't be captured: %v", err)
+		}
+	}()
+	return inspector
+}
+
+// printForwarding prints where each public URL is forwarded to
+func printForwarding(publicURL string, target string) {
+	fmt.Printf("Forwarding %s -> %s\n", publicURL, target)
+}
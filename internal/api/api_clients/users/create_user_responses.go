@@ -36,6 +36,12 @@ func (o *CreateUserReader) ReadResponse(response runtime.ClientResponse, consume
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewCreateUserConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewCreateUserInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -43,7 +49,7 @@ func (o *CreateUserReader) ReadResponse(response runtime.ClientResponse, consume
 		}
 		return nil, result
 	default:
-		return nil, runtime.NewAPIError("[POST /api/v1/users] createUser", response, response.Code())
+		return nil, runtime.NewAPIError("[POST /v1/users] createUser", response, response.Code())
 	}
 }
 
@@ -58,7 +64,7 @@ CreateUserOK describes a response with status code 200, with default header valu
 OK
 */
 type CreateUserOK struct {
-	Payload *api_models.ModelsUser
+	Payload *api_models.HandlersCreateUserResponse
 }
 
 // IsSuccess returns true when this create user o k response has a 2xx status code
@@ -93,21 +99,21 @@ func (o *CreateUserOK) Code() int {
 
 func (o *CreateUserOK) Error() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[POST /api/v1/users][%d] createUserOK %s", 200, payload)
+	return fmt.Sprintf("[POST /v1/users][%d] createUserOK %s", 200, payload)
 }
 
 func (o *CreateUserOK) String() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[POST /api/v1/users][%d] createUserOK %s", 200, payload)
+	return fmt.Sprintf("[POST /v1/users][%d] createUserOK %s", 200, payload)
 }
 
-func (o *CreateUserOK) GetPayload() *api_models.ModelsUser {
+func (o *CreateUserOK) GetPayload() *api_models.HandlersCreateUserResponse {
 	return o.Payload
 }
 
 func (o *CreateUserOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
-	o.Payload = new(api_models.ModelsUser)
+	o.Payload = new(api_models.HandlersCreateUserResponse)
 
 	// response payload
 	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
@@ -163,12 +169,12 @@ func (o *CreateUserBadRequest) Code() int {
 
 func (o *CreateUserBadRequest) Error() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[POST /api/v1/users][%d] createUserBadRequest %s", 400, payload)
+	return fmt.Sprintf("[POST /v1/users][%d] createUserBadRequest %s", 400, payload)
 }
 
 func (o *CreateUserBadRequest) String() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[POST /api/v1/users][%d] createUserBadRequest %s", 400, payload)
+	return fmt.Sprintf("[POST /v1/users][%d] createUserBadRequest %s", 400, payload)
 }
 
 func (o *CreateUserBadRequest) GetPayload() *api_models.ModelsValidationError {
@@ -187,6 +193,76 @@ func (o *CreateUserBadRequest) readResponse(response runtime.ClientResponse, con
 	return nil
 }
 
+// NewCreateUserConflict creates a CreateUserConflict with default headers values
+func NewCreateUserConflict() *CreateUserConflict {
+	return &CreateUserConflict{}
+}
+
+/*
+CreateUserConflict describes a response with status code 409, with default header values.
+
+Conflict
+*/
+type CreateUserConflict struct {
+	Payload *api_models.ModelsConflictsError
+}
+
+// IsSuccess returns true when this create user conflict response has a 2xx status code
+func (o *CreateUserConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create user conflict response has a 3xx status code
+func (o *CreateUserConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create user conflict response has a 4xx status code
+func (o *CreateUserConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create user conflict response has a 5xx status code
+func (o *CreateUserConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create user conflict response a status code equal to that given
+func (o *CreateUserConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the create user conflict response
+func (o *CreateUserConflict) Code() int {
+	return 409
+}
+
+func (o *CreateUserConflict) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1/users][%d] createUserConflict %s", 409, payload)
+}
+
+func (o *CreateUserConflict) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1/users][%d] createUserConflict %s", 409, payload)
+}
+
+func (o *CreateUserConflict) GetPayload() *api_models.ModelsConflictsError {
+	return o.Payload
+}
+
+func (o *CreateUserConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(api_models.ModelsConflictsError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewCreateUserInternalServerError creates a CreateUserInternalServerError with default headers values
 func NewCreateUserInternalServerError() *CreateUserInternalServerError {
 	return &CreateUserInternalServerError{}
@@ -233,12 +309,12 @@ func (o *CreateUserInternalServerError) Code() int {
 
 func (o *CreateUserInternalServerError) Error() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[POST /api/v1/users][%d] createUserInternalServerError %s", 500, payload)
+	return fmt.Sprintf("[POST /v1/users][%d] createUserInternalServerError %s", 500, payload)
 }
 
 func (o *CreateUserInternalServerError) String() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[POST /api/v1/users][%d] createUserInternalServerError %s", 500, payload)
+	return fmt.Sprintf("[POST /v1/users][%d] createUserInternalServerError %s", 500, payload)
 }
 
 func (o *CreateUserInternalServerError) GetPayload() *api_models.ModelsBaseError {
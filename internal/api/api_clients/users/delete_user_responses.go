@@ -30,6 +30,18 @@ func (o *DeleteUserReader) ReadResponse(response runtime.ClientResponse, consume
 			return nil, err
 		}
 		return result, nil
+	case 401:
+		result := NewDeleteUserUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewDeleteUserForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewDeleteUserInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -37,7 +49,7 @@ func (o *DeleteUserReader) ReadResponse(response runtime.ClientResponse, consume
 		}
 		return nil, result
 	default:
-		return nil, runtime.NewAPIError("[DELETE /api/v1/users/{id}] deleteUser", response, response.Code())
+		return nil, runtime.NewAPIError("[DELETE /v1/users/{id}] deleteUser", response, response.Code())
 	}
 }
 
@@ -87,12 +99,12 @@ func (o *DeleteUserOK) Code() int {
 
 func (o *DeleteUserOK) Error() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[DELETE /api/v1/users/{id}][%d] deleteUserOK %s", 200, payload)
+	return fmt.Sprintf("[DELETE /v1/users/{id}][%d] deleteUserOK %s", 200, payload)
 }
 
 func (o *DeleteUserOK) String() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[DELETE /api/v1/users/{id}][%d] deleteUserOK %s", 200, payload)
+	return fmt.Sprintf("[DELETE /v1/users/{id}][%d] deleteUserOK %s", 200, payload)
 }
 
 func (o *DeleteUserOK) GetPayload() *api_models.ModelsUser {
@@ -111,6 +123,146 @@ func (o *DeleteUserOK) readResponse(response runtime.ClientResponse, consumer ru
 	return nil
 }
 
+// NewDeleteUserUnauthorized creates a DeleteUserUnauthorized with default headers values
+func NewDeleteUserUnauthorized() *DeleteUserUnauthorized {
+	return &DeleteUserUnauthorized{}
+}
+
+/*
+DeleteUserUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized
+*/
+type DeleteUserUnauthorized struct {
+	Payload *api_models.ModelsUnauthorizedError
+}
+
+// IsSuccess returns true when this delete user unauthorized response has a 2xx status code
+func (o *DeleteUserUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete user unauthorized response has a 3xx status code
+func (o *DeleteUserUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete user unauthorized response has a 4xx status code
+func (o *DeleteUserUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete user unauthorized response has a 5xx status code
+func (o *DeleteUserUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete user unauthorized response a status code equal to that given
+func (o *DeleteUserUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the delete user unauthorized response
+func (o *DeleteUserUnauthorized) Code() int {
+	return 401
+}
+
+func (o *DeleteUserUnauthorized) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /v1/users/{id}][%d] deleteUserUnauthorized %s", 401, payload)
+}
+
+func (o *DeleteUserUnauthorized) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /v1/users/{id}][%d] deleteUserUnauthorized %s", 401, payload)
+}
+
+func (o *DeleteUserUnauthorized) GetPayload() *api_models.ModelsUnauthorizedError {
+	return o.Payload
+}
+
+func (o *DeleteUserUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(api_models.ModelsUnauthorizedError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteUserForbidden creates a DeleteUserForbidden with default headers values
+func NewDeleteUserForbidden() *DeleteUserForbidden {
+	return &DeleteUserForbidden{}
+}
+
+/*
+DeleteUserForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type DeleteUserForbidden struct {
+	Payload *api_models.ModelsNotAllowedError
+}
+
+// IsSuccess returns true when this delete user forbidden response has a 2xx status code
+func (o *DeleteUserForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete user forbidden response has a 3xx status code
+func (o *DeleteUserForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete user forbidden response has a 4xx status code
+func (o *DeleteUserForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete user forbidden response has a 5xx status code
+func (o *DeleteUserForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete user forbidden response a status code equal to that given
+func (o *DeleteUserForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the delete user forbidden response
+func (o *DeleteUserForbidden) Code() int {
+	return 403
+}
+
+func (o *DeleteUserForbidden) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /v1/users/{id}][%d] deleteUserForbidden %s", 403, payload)
+}
+
+func (o *DeleteUserForbidden) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /v1/users/{id}][%d] deleteUserForbidden %s", 403, payload)
+}
+
+func (o *DeleteUserForbidden) GetPayload() *api_models.ModelsNotAllowedError {
+	return o.Payload
+}
+
+func (o *DeleteUserForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(api_models.ModelsNotAllowedError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewDeleteUserInternalServerError creates a DeleteUserInternalServerError with default headers values
 func NewDeleteUserInternalServerError() *DeleteUserInternalServerError {
 	return &DeleteUserInternalServerError{}
@@ -157,12 +309,12 @@ func (o *DeleteUserInternalServerError) Code() int {
 
 func (o *DeleteUserInternalServerError) Error() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[DELETE /api/v1/users/{id}][%d] deleteUserInternalServerError %s", 500, payload)
+	return fmt.Sprintf("[DELETE /v1/users/{id}][%d] deleteUserInternalServerError %s", 500, payload)
 }
 
 func (o *DeleteUserInternalServerError) String() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[DELETE /api/v1/users/{id}][%d] deleteUserInternalServerError %s", 500, payload)
+	return fmt.Sprintf("[DELETE /v1/users/{id}][%d] deleteUserInternalServerError %s", 500, payload)
 }
 
 func (o *DeleteUserInternalServerError) GetPayload() *api_models.ModelsBaseError {
@@ -30,6 +30,18 @@ func (o *GetUserReader) ReadResponse(response runtime.ClientResponse, consumer r
 			return nil, err
 		}
 		return result, nil
+	case 401:
+		result := NewGetUserUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewGetUserForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewGetUserInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -37,7 +49,7 @@ func (o *GetUserReader) ReadResponse(response runtime.ClientResponse, consumer r
 		}
 		return nil, result
 	default:
-		return nil, runtime.NewAPIError("[GET /api/v1/users/{id}] getUser", response, response.Code())
+		return nil, runtime.NewAPIError("[GET /v1/users/{id}] getUser", response, response.Code())
 	}
 }
 
@@ -87,12 +99,12 @@ func (o *GetUserOK) Code() int {
 
 func (o *GetUserOK) Error() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[GET /api/v1/users/{id}][%d] getUserOK %s", 200, payload)
+	return fmt.Sprintf("[GET /v1/users/{id}][%d] getUserOK %s", 200, payload)
 }
 
 func (o *GetUserOK) String() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[GET /api/v1/users/{id}][%d] getUserOK %s", 200, payload)
+	return fmt.Sprintf("[GET /v1/users/{id}][%d] getUserOK %s", 200, payload)
 }
 
 func (o *GetUserOK) GetPayload() *api_models.ModelsUser {
@@ -111,6 +123,146 @@ func (o *GetUserOK) readResponse(response runtime.ClientResponse, consumer runti
 	return nil
 }
 
+// NewGetUserUnauthorized creates a GetUserUnauthorized with default headers values
+func NewGetUserUnauthorized() *GetUserUnauthorized {
+	return &GetUserUnauthorized{}
+}
+
+/*
+GetUserUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized
+*/
+type GetUserUnauthorized struct {
+	Payload *api_models.ModelsUnauthorizedError
+}
+
+// IsSuccess returns true when this get user unauthorized response has a 2xx status code
+func (o *GetUserUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get user unauthorized response has a 3xx status code
+func (o *GetUserUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get user unauthorized response has a 4xx status code
+func (o *GetUserUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get user unauthorized response has a 5xx status code
+func (o *GetUserUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get user unauthorized response a status code equal to that given
+func (o *GetUserUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the get user unauthorized response
+func (o *GetUserUnauthorized) Code() int {
+	return 401
+}
+
+func (o *GetUserUnauthorized) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1/users/{id}][%d] getUserUnauthorized %s", 401, payload)
+}
+
+func (o *GetUserUnauthorized) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1/users/{id}][%d] getUserUnauthorized %s", 401, payload)
+}
+
+func (o *GetUserUnauthorized) GetPayload() *api_models.ModelsUnauthorizedError {
+	return o.Payload
+}
+
+func (o *GetUserUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(api_models.ModelsUnauthorizedError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetUserForbidden creates a GetUserForbidden with default headers values
+func NewGetUserForbidden() *GetUserForbidden {
+	return &GetUserForbidden{}
+}
+
+/*
+GetUserForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type GetUserForbidden struct {
+	Payload *api_models.ModelsNotAllowedError
+}
+
+// IsSuccess returns true when this get user forbidden response has a 2xx status code
+func (o *GetUserForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get user forbidden response has a 3xx status code
+func (o *GetUserForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get user forbidden response has a 4xx status code
+func (o *GetUserForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get user forbidden response has a 5xx status code
+func (o *GetUserForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get user forbidden response a status code equal to that given
+func (o *GetUserForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the get user forbidden response
+func (o *GetUserForbidden) Code() int {
+	return 403
+}
+
+func (o *GetUserForbidden) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1/users/{id}][%d] getUserForbidden %s", 403, payload)
+}
+
+func (o *GetUserForbidden) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1/users/{id}][%d] getUserForbidden %s", 403, payload)
+}
+
+func (o *GetUserForbidden) GetPayload() *api_models.ModelsNotAllowedError {
+	return o.Payload
+}
+
+func (o *GetUserForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(api_models.ModelsNotAllowedError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewGetUserInternalServerError creates a GetUserInternalServerError with default headers values
 func NewGetUserInternalServerError() *GetUserInternalServerError {
 	return &GetUserInternalServerError{}
@@ -157,12 +309,12 @@ func (o *GetUserInternalServerError) Code() int {
 
 func (o *GetUserInternalServerError) Error() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[GET /api/v1/users/{id}][%d] getUserInternalServerError %s", 500, payload)
+	return fmt.Sprintf("[GET /v1/users/{id}][%d] getUserInternalServerError %s", 500, payload)
 }
 
 func (o *GetUserInternalServerError) String() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[GET /api/v1/users/{id}][%d] getUserInternalServerError %s", 500, payload)
+	return fmt.Sprintf("[GET /v1/users/{id}][%d] getUserInternalServerError %s", 500, payload)
 }
 
 func (o *GetUserInternalServerError) GetPayload() *api_models.ModelsBaseError {
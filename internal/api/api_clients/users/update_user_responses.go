@@ -36,6 +36,18 @@ func (o *UpdateUserReader) ReadResponse(response runtime.ClientResponse, consume
 			return nil, err
 		}
 		return nil, result
+	case 401:
+		result := NewUpdateUserUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewUpdateUserForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewUpdateUserInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -43,7 +55,7 @@ func (o *UpdateUserReader) ReadResponse(response runtime.ClientResponse, consume
 		}
 		return nil, result
 	default:
-		return nil, runtime.NewAPIError("[PUT /api/v1/users/{id}] updateUser", response, response.Code())
+		return nil, runtime.NewAPIError("[PUT /v1/users/{id}] updateUser", response, response.Code())
 	}
 }
 
@@ -93,12 +105,12 @@ func (o *UpdateUserOK) Code() int {
 
 func (o *UpdateUserOK) Error() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[PUT /api/v1/users/{id}][%d] updateUserOK %s", 200, payload)
+	return fmt.Sprintf("[PUT /v1/users/{id}][%d] updateUserOK %s", 200, payload)
 }
 
 func (o *UpdateUserOK) String() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[PUT /api/v1/users/{id}][%d] updateUserOK %s", 200, payload)
+	return fmt.Sprintf("[PUT /v1/users/{id}][%d] updateUserOK %s", 200, payload)
 }
 
 func (o *UpdateUserOK) GetPayload() *api_models.ModelsUser {
@@ -163,12 +175,12 @@ func (o *UpdateUserBadRequest) Code() int {
 
 func (o *UpdateUserBadRequest) Error() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[PUT /api/v1/users/{id}][%d] updateUserBadRequest %s", 400, payload)
+	return fmt.Sprintf("[PUT /v1/users/{id}][%d] updateUserBadRequest %s", 400, payload)
 }
 
 func (o *UpdateUserBadRequest) String() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[PUT /api/v1/users/{id}][%d] updateUserBadRequest %s", 400, payload)
+	return fmt.Sprintf("[PUT /v1/users/{id}][%d] updateUserBadRequest %s", 400, payload)
 }
 
 func (o *UpdateUserBadRequest) GetPayload() *api_models.ModelsValidationError {
@@ -187,6 +199,146 @@ func (o *UpdateUserBadRequest) readResponse(response runtime.ClientResponse, con
 	return nil
 }
 
+// NewUpdateUserUnauthorized creates a UpdateUserUnauthorized with default headers values
+func NewUpdateUserUnauthorized() *UpdateUserUnauthorized {
+	return &UpdateUserUnauthorized{}
+}
+
+/*
+UpdateUserUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized
+*/
+type UpdateUserUnauthorized struct {
+	Payload *api_models.ModelsUnauthorizedError
+}
+
+// IsSuccess returns true when this update user unauthorized response has a 2xx status code
+func (o *UpdateUserUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update user unauthorized response has a 3xx status code
+func (o *UpdateUserUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update user unauthorized response has a 4xx status code
+func (o *UpdateUserUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update user unauthorized response has a 5xx status code
+func (o *UpdateUserUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update user unauthorized response a status code equal to that given
+func (o *UpdateUserUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the update user unauthorized response
+func (o *UpdateUserUnauthorized) Code() int {
+	return 401
+}
+
+func (o *UpdateUserUnauthorized) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /v1/users/{id}][%d] updateUserUnauthorized %s", 401, payload)
+}
+
+func (o *UpdateUserUnauthorized) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /v1/users/{id}][%d] updateUserUnauthorized %s", 401, payload)
+}
+
+func (o *UpdateUserUnauthorized) GetPayload() *api_models.ModelsUnauthorizedError {
+	return o.Payload
+}
+
+func (o *UpdateUserUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(api_models.ModelsUnauthorizedError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateUserForbidden creates a UpdateUserForbidden with default headers values
+func NewUpdateUserForbidden() *UpdateUserForbidden {
+	return &UpdateUserForbidden{}
+}
+
+/*
+UpdateUserForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type UpdateUserForbidden struct {
+	Payload *api_models.ModelsNotAllowedError
+}
+
+// IsSuccess returns true when this update user forbidden response has a 2xx status code
+func (o *UpdateUserForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update user forbidden response has a 3xx status code
+func (o *UpdateUserForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update user forbidden response has a 4xx status code
+func (o *UpdateUserForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update user forbidden response has a 5xx status code
+func (o *UpdateUserForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update user forbidden response a status code equal to that given
+func (o *UpdateUserForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the update user forbidden response
+func (o *UpdateUserForbidden) Code() int {
+	return 403
+}
+
+func (o *UpdateUserForbidden) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /v1/users/{id}][%d] updateUserForbidden %s", 403, payload)
+}
+
+func (o *UpdateUserForbidden) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /v1/users/{id}][%d] updateUserForbidden %s", 403, payload)
+}
+
+func (o *UpdateUserForbidden) GetPayload() *api_models.ModelsNotAllowedError {
+	return o.Payload
+}
+
+func (o *UpdateUserForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(api_models.ModelsNotAllowedError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewUpdateUserInternalServerError creates a UpdateUserInternalServerError with default headers values
 func NewUpdateUserInternalServerError() *UpdateUserInternalServerError {
 	return &UpdateUserInternalServerError{}
@@ -233,12 +385,12 @@ func (o *UpdateUserInternalServerError) Code() int {
 
 func (o *UpdateUserInternalServerError) Error() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[PUT /api/v1/users/{id}][%d] updateUserInternalServerError %s", 500, payload)
+	return fmt.Sprintf("[PUT /v1/users/{id}][%d] updateUserInternalServerError %s", 500, payload)
 }
 
 func (o *UpdateUserInternalServerError) String() string {
 	payload, _ := json.Marshal(o.Payload)
-	return fmt.Sprintf("[PUT /api/v1/users/{id}][%d] updateUserInternalServerError %s", 500, payload)
+	return fmt.Sprintf("[PUT /v1/users/{id}][%d] updateUserInternalServerError %s", 500, payload)
 }
 
 func (o *UpdateUserInternalServerError) GetPayload() *api_models.ModelsBaseError {
@@ -58,11 +58,11 @@ type ClientOption func(*runtime.ClientOperation)
 type ClientService interface {
 	CreateUser(params *CreateUserParams, opts ...ClientOption) (*CreateUserOK, error)
 
-	DeleteUser(params *DeleteUserParams, opts ...ClientOption) (*DeleteUserOK, error)
+	DeleteUser(params *DeleteUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteUserOK, error)
 
-	GetUser(params *GetUserParams, opts ...ClientOption) (*GetUserOK, error)
+	GetUser(params *GetUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetUserOK, error)
 
-	UpdateUser(params *UpdateUserParams, opts ...ClientOption) (*UpdateUserOK, error)
+	UpdateUser(params *UpdateUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UpdateUserOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
@@ -70,7 +70,7 @@ type ClientService interface {
 /*
 CreateUser creates user
 
-Creates a user
+Creates a user along with their first API token, which is only returned in this response. Further tokens are created with it.
 */
 func (a *Client) CreateUser(params *CreateUserParams, opts ...ClientOption) (*CreateUserOK, error) {
 	// TODO: Validate the params before sending
@@ -80,7 +80,7 @@ func (a *Client) CreateUser(params *CreateUserParams, opts ...ClientOption) (*Cr
 	op := &runtime.ClientOperation{
 		ID:                 "createUser",
 		Method:             "POST",
-		PathPattern:        "/api/v1/users",
+		PathPattern:        "/v1/users",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
@@ -110,9 +110,9 @@ func (a *Client) CreateUser(params *CreateUserParams, opts ...ClientOption) (*Cr
 /*
 DeleteUser deletes user
 
-Deletes a user
+Deletes the authenticated user
 */
-func (a *Client) DeleteUser(params *DeleteUserParams, opts ...ClientOption) (*DeleteUserOK, error) {
+func (a *Client) DeleteUser(params *DeleteUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteUserOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteUserParams()
@@ -120,12 +120,13 @@ func (a *Client) DeleteUser(params *DeleteUserParams, opts ...ClientOption) (*De
 	op := &runtime.ClientOperation{
 		ID:                 "deleteUser",
 		Method:             "DELETE",
-		PathPattern:        "/api/v1/users/{id}",
+		PathPattern:        "/v1/users/{id}",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
 		Reader:             &DeleteUserReader{formats: a.formats},
+		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -150,9 +151,9 @@ func (a *Client) DeleteUser(params *DeleteUserParams, opts ...ClientOption) (*De
 /*
 GetUser gets user for specified id
 
-Gets users
+Gets the authenticated user along with their organizations
 */
-func (a *Client) GetUser(params *GetUserParams, opts ...ClientOption) (*GetUserOK, error) {
+func (a *Client) GetUser(params *GetUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetUserOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewGetUserParams()
@@ -160,12 +161,13 @@ func (a *Client) GetUser(params *GetUserParams, opts ...ClientOption) (*GetUserO
 	op := &runtime.ClientOperation{
 		ID:                 "getUser",
 		Method:             "GET",
-		PathPattern:        "/api/v1/users/{id}",
+		PathPattern:        "/v1/users/{id}",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
 		Reader:             &GetUserReader{formats: a.formats},
+		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -190,9 +192,9 @@ func (a *Client) GetUser(params *GetUserParams, opts ...ClientOption) (*GetUserO
 /*
 UpdateUser updates user
 
-Updates a user
+Updates the authenticated user, the current organization can only be set to one the user is a member of
 */
-func (a *Client) UpdateUser(params *UpdateUserParams, opts ...ClientOption) (*UpdateUserOK, error) {
+func (a *Client) UpdateUser(params *UpdateUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UpdateUserOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewUpdateUserParams()
@@ -200,12 +202,13 @@ func (a *Client) UpdateUser(params *UpdateUserParams, opts ...ClientOption) (*Up
 	op := &runtime.ClientOperation{
 		ID:                 "updateUser",
 		Method:             "PUT",
-		PathPattern:        "/api/v1/users/{id}",
+		PathPattern:        "/v1/users/{id}",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
 		Reader:             &UpdateUserReader{formats: a.formats},
+		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
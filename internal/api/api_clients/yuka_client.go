@@ -10,6 +10,12 @@ import (
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"yuka/internal/api/api_clients/api_tokens"
+	"yuka/internal/api/api_clients/connections"
+	"yuka/internal/api/api_clients/domains"
+	"yuka/internal/api/api_clients/invitations"
+	"yuka/internal/api/api_clients/organizations"
+	"yuka/internal/api/api_clients/tunnels"
 	"yuka/internal/api/api_clients/users"
 )
 
@@ -55,6 +61,12 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Yuka {
 
 	cli := new(Yuka)
 	cli.Transport = transport
+	cli.APITokens = api_tokens.New(transport, formats)
+	cli.Connections = connections.New(transport, formats)
+	cli.Domains = domains.New(transport, formats)
+	cli.Invitations = invitations.New(transport, formats)
+	cli.Organizations = organizations.New(transport, formats)
+	cli.Tunnels = tunnels.New(transport, formats)
 	cli.Users = users.New(transport, formats)
 	return cli
 }
@@ -100,6 +112,18 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 
 // Yuka is a client for yuka
 type Yuka struct {
+	APITokens api_tokens.ClientService
+
+	Connections connections.ClientService
+
+	Domains domains.ClientService
+
+	Invitations invitations.ClientService
+
+	Organizations organizations.ClientService
+
+	Tunnels tunnels.ClientService
+
 	Users users.ClientService
 
 	Transport runtime.ClientTransport
@@ -108,5 +132,11 @@ type Yuka struct {
 // SetTransport changes the transport on the client and all its subresources
 func (c *Yuka) SetTransport(transport runtime.ClientTransport) {
 	c.Transport = transport
+	c.APITokens.SetTransport(transport)
+	c.Connections.SetTransport(transport)
+	c.Domains.SetTransport(transport)
+	c.Invitations.SetTransport(transport)
+	c.Organizations.SetTransport(transport)
+	c.Tunnels.SetTransport(transport)
 	c.Users.SetTransport(transport)
 }
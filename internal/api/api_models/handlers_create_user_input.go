@@ -23,10 +23,6 @@ type HandlersCreateUserInput struct {
 	// Required: true
 	AuthID *string `json:"auth_id"`
 
-	// current organization id
-	// Required: true
-	CurrentOrganizationID *string `json:"current_organization_id"`
-
 	// device token
 	// Required: true
 	DeviceToken *string `json:"device_token"`
@@ -44,10 +40,6 @@ func (m *HandlersCreateUserInput) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
-	if err := m.validateCurrentOrganizationID(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if err := m.validateDeviceToken(formats); err != nil {
 		res = append(res, err)
 	}
@@ -71,15 +63,6 @@ func (m *HandlersCreateUserInput) validateAuthID(formats strfmt.Registry) error
 	return nil
 }
 
-func (m *HandlersCreateUserInput) validateCurrentOrganizationID(formats strfmt.Registry) error {
-
-	if err := validate.Required("current_organization_id", "body", m.CurrentOrganizationID); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 func (m *HandlersCreateUserInput) validateDeviceToken(formats strfmt.Registry) error {
 
 	if err := validate.Required("device_token", "body", m.DeviceToken); err != nil {
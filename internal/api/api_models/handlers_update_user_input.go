@@ -17,7 +17,7 @@ import (
 // swagger:model handlers.UpdateUserInput
 type HandlersUpdateUserInput struct {
 
-	// current organization id
+	// CurrentOrganizationId must be an organization the user is a member of
 	CurrentOrganizationID string `json:"current_organization_id,omitempty"`
 
 	// device token
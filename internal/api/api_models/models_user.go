@@ -7,7 +7,9 @@ package api_models
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -30,17 +32,89 @@ type ModelsUser struct {
 	// Example: aa22666c-0f57-45cb-a449-16efecc04f2e
 	ID string `json:"id,omitempty"`
 
-	// Organizations         []Organization `json:"organizations" gorm:"many2many:user_organizations;"`
+	// organizations
+	Organizations []*ModelsOrganization `json:"organizations"`
+
+	// username
 	Username string `json:"username,omitempty"`
 }
 
 // Validate validates this models user
 func (m *ModelsUser) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateOrganizations(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ModelsUser) validateOrganizations(formats strfmt.Registry) error {
+	if swag.IsZero(m.Organizations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Organizations); i++ {
+		if swag.IsZero(m.Organizations[i]) { // not required
+			continue
+		}
+
+		if m.Organizations[i] != nil {
+			if err := m.Organizations[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("organizations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("organizations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
-// ContextValidate validates this models user based on context it is used
+// ContextValidate validate this models user based on the context it is used
 func (m *ModelsUser) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateOrganizations(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ModelsUser) contextValidateOrganizations(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Organizations); i++ {
+
+		if m.Organizations[i] != nil {
+
+			if swag.IsZero(m.Organizations[i]) { // not required
+				return nil
+			}
+
+			if err := m.Organizations[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("organizations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("organizations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
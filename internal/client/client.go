@@ -2,11 +2,14 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
 	"os"
 	"yuka/internal/api/api_clients"
+	"yuka/pkg/streaming_connection"
 
 	"go.uber.org/zap"
 
+	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 )
@@ -16,10 +19,20 @@ type Client struct {
 	ApiClient        *api_clients.Yuka
 	Logger           *zap.Logger
 	slogger          *zap.SugaredLogger
-	Hostname         string
+	// TunnelAddress is the address of the server's tunnel listener that agents connect to
+	TunnelAddress string
+	// AuthToken is the API token used to authenticate with the tunnel server
+	AuthToken string
+	// Transport is how the agent connects to the server
+	Transport Transport
+	// TunnelTLSConfig secures the connection to the tunnel listener, nil when it's plaintext
+	TunnelTLSConfig *tls.Config
+	// WebSocketAddress is the address of the api server listener agents connect to with TransportWs, the api
+	// server address when it's empty. It must be the api server's TLS listener when TunnelTLSConfig is set.
+	WebSocketAddress string
 }
 
-func NewClient(apiserverAddress string, logger *zap.Logger, hostname string) *Client {
+func NewClient(apiserverAddress string, logger *zap.Logger, tunnelAddress string, authToken string, tunnelTransport Transport, tunnelTLSConfig *tls.Config) *Client {
 	transport := httptransport.New(apiserverAddress, "", nil)
 	transport.DefaultAuthentication = httptransport.BasicAuth(os.Getenv("HTTP_USERNAME"), os.Getenv("HTTP_PASSWORD"))
 	return &Client{
@@ -27,12 +40,68 @@ func NewClient(apiserverAddress string, logger *zap.Logger, hostname string) *Cl
 		ApiClient:        api_clients.New(transport, strfmt.Default),
 		Logger:           logger,
 		slogger:          logger.Sugar(),
-		Hostname:         hostname,
+		TunnelAddress:    tunnelAddress,
+		AuthToken:        authToken,
+		Transport:        tunnelTransport,
+		TunnelTLSConfig:  tunnelTLSConfig,
 	}
 }
 
-func (c *Client) Start(ctx context.Context) error {
-	tunnel := NewTunnel(c.Logger, "localhost:8085", "localhost:5432")
+// ApiAuth authenticates api server requests made on behalf of the user the client's API token belongs to
+func (c *Client) ApiAuth() runtime.ClientAuthInfoWriter {
+	return httptransport.BearerToken(c.AuthToken)
+}
+
+// NewTunnel builds a Tunnel that registers tunnels with the server and serves them over one connection
+func (c *Client) NewTunnel(tunnels []TunnelSpec, onRegistered func([]streaming_connection.TunnelAssignment)) *Tunnel {
+	return NewTunnel(c.Logger, TunnelConfig{
+		ServerAddress: c.TunnelAddress,
+		Transport:     c.Transport,
+		WebSocketURL:  c.webSocketURL(),
+		TLSConfig:     c.TunnelTLSConfig,
+		AuthToken:     c.AuthToken,
+		Tunnels:       tunnels,
+		OnRegistered:  onRegistered,
+	})
+}
+
+// webSocketURL returns the URL agents connect to with TransportWs, over wss when TLS is configured
+func (c *Client) webSocketURL() string {
+	address := c.WebSocketAddress
+	if address == "" {
+		address = c.apiServerAddress
+	}
+	return webSocketURL(address, c.TunnelTLSConfig != nil)
+}
+
+// Start registers tunnels with the server and serves them until ctx is cancelled
+func (c *Client) Start(ctx context.Context, tunnels []TunnelSpec, onRegistered func([]streaming_connection.TunnelAssignment)) error {
+	return c.serve(ctx, c.NewTunnel(tunnels, onRegistered))
+}
+
+// StartDetached is like Start but also serves the control API used by a detached agent, stop or the
+// control API's stop endpoint shutting the tunnel down
+func (c *Client) StartDetached(ctx context.Context, stop context.CancelFunc, paths *DaemonPaths, tunnels []TunnelSpec) error {
+	tunnel := c.NewTunnel(tunnels, nil)
+
+	daemonErr := make(chan error, 1)
+	go func() {
+		daemonErr <- RunDaemon(ctx, c.Logger, paths, tunnel, stop)
+	}()
+
+	err := c.serve(ctx, tunnel)
+	// Make sure the pidfile and socket are gone before returning, even if the tunnel was rejected
+	stop()
+	if daemonErr := <-daemonErr; daemonErr != nil {
+		c.slogger.Errorf("Error occurred serving control api: %v", daemonErr)
+		if err == nil {
+			err = daemonErr
+		}
+	}
+	return err
+}
+
+func (c *Client) serve(ctx context.Context, tunnel *Tunnel) error {
 	if err := tunnel.Connect(ctx); err != nil {
 		c.slogger.Errorf("Error occurred when listening on tunnel: %v", err)
 		return err
@@ -0,0 +1,170 @@
+package client
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"yuka/pkg/streaming_connection"
+	"yuka/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+/*
+ * Tunnels can be declared in the tunnels section of the yukactl config file and started by name:
+ *
+ *   tunnels:
+ *     - name: web
+ *       protocol: http
+ *       addr: 3000
+ *       subdomain: my-app
+ *       auth:
+ *         username: admin
+ *         password: secret
+ *       request-headers:
+ *         add:
+ *           X-Forwarded-By: yuka
+ *     - name: db
+ *       protocol: tcp
+ *       addr: localhost:5432
+ *     - name: dns
+ *       protocol: udp
+ *       addr: 53
+ *       remote-port: 5353
+ **/
+
+// TunnelsConfig is the tunnels section of the yukactl config file
+type TunnelsConfig struct {
+	Tunnels []TunnelDefinition `mapstructure:"tunnels" validate:"unique=Name,dive"`
+}
+
+// TunnelDefinition is a tunnel declared in the config file
+type TunnelDefinition struct {
+	Name     string `mapstructure:"name" validate:"required,printascii,excludesall= "`
+	Protocol string `mapstructure:"protocol" validate:"required,oneof=http tcp tls udp"`
+	// Addr is the address of the local service, a port on its own is a port on localhost
+	Addr string `mapstructure:"addr" validate:"required,isAddress"`
+	// Subdomain is requested for http and tls tunnels, the server picks one when it's empty
+	Subdomain string `mapstructure:"subdomain" validate:"omitempty,isSubdomain,excluded_if=Protocol tcp,excluded_if=Protocol udp"`
+	// RemotePort is requested for tcp and udp tunnels, the server picks one when it's empty
+	RemotePort    int    `mapstructure:"remote-port" validate:"omitempty,min=1,max=65535,excluded_if=Protocol http,excluded_if=Protocol tls"`
+	LoadBalancing string `mapstructure:"load-balancing" validate:"omitempty,oneof=round-robin least-in-flight consistent-hash"`
+
+	// The rest of the options only apply to http tunnels
+	Scheme          string       `mapstructure:"scheme" validate:"omitempty,oneof=http https,excluded_unless=Protocol http"`
+	HostHeader      string       `mapstructure:"host-header" validate:"excluded_unless=Protocol http"`
+	Auth            *BasicAuth   `mapstructure:"auth" validate:"excluded_unless=Protocol http"`
+	RequestHeaders  *HeaderRules `mapstructure:"request-headers" validate:"excluded_unless=Protocol http"`
+	ResponseHeaders *HeaderRules `mapstructure:"response-headers" validate:"excluded_unless=Protocol http"`
+}
+
+// LoadTunnelsConfig reads and validates the tunnels section of the config
+func LoadTunnelsConfig(configurator *utils.Configurator) (*TunnelsConfig, error) {
+	var config TunnelsConfig
+	if err := configurator.Unmarshal(&config); err != nil {
+		return nil, fmt.Errorf("invalid tunnels config: %v", err)
+	}
+	return &config, nil
+}
+
+// Select returns the tunnels with names in the order given, every tunnel is returned if names is empty
+func (c *TunnelsConfig) Select(names []string) ([]TunnelDefinition, error) {
+	if len(c.Tunnels) == 0 {
+		return nil, fmt.Errorf("no tunnels are defined in the config file")
+	}
+	if len(names) == 0 {
+		return c.Tunnels, nil
+	}
+
+	definitions := make([]TunnelDefinition, 0, len(names))
+	for _, name := range names {
+		definition, ok := c.find(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown tunnel %q, the config file defines %s", name, strings.Join(c.names(), ", "))
+		}
+		definitions = append(definitions, definition)
+	}
+	return definitions, nil
+}
+
+func (c *TunnelsConfig) find(name string) (TunnelDefinition, bool) {
+	for _, definition := range c.Tunnels {
+		if definition.Name == name {
+			return definition, true
+		}
+	}
+	return TunnelDefinition{}, false
+}
+
+func (c *TunnelsConfig) names() []string {
+	names := make([]string, len(c.Tunnels))
+	for i, definition := range c.Tunnels {
+		names[i] = definition.Name
+	}
+	return names
+}
+
+// LocalAddress returns the host:port of the local service
+func (d *TunnelDefinition) LocalAddress() string {
+	host, port, err := net.SplitHostPort(d.Addr)
+	if err != nil {
+		port = d.Addr
+	}
+	if host == "" {
+		host = "localhost"
+	}
+	return net.JoinHostPort(host, port)
+}
+
+// Target describes where the tunnel forwards to, i.e http://localhost:3000
+func (d *TunnelDefinition) Target() string {
+	if d.Protocol != streaming_connection.TunnelProtocolHttp {
+		return d.LocalAddress()
+	}
+	return d.upstream().String()
+}
+
+func (d *TunnelDefinition) upstream() *url.URL {
+	scheme := d.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	return &url.URL{Scheme: scheme, Host: d.LocalAddress()}
+}
+
+// Spec builds the TunnelSpec that serves the tunnel, inspector is optional and captures the requests of
+// http tunnels
+func (d *TunnelDefinition) Spec(logger *zap.Logger, inspector *Inspector) TunnelSpec {
+	spec := TunnelSpec{
+		TunnelRequest: streaming_connection.TunnelRequest{
+			Name:                  d.Name,
+			Protocol:              d.Protocol,
+			Hostname:              d.Subdomain,
+			RemotePort:            d.RemotePort,
+			LoadBalancingStrategy: d.LoadBalancing,
+		},
+	}
+	switch d.Protocol {
+	case streaming_connection.TunnelProtocolUdp:
+		spec.Handler = NewUdpForwarder(logger, d.LocalAddress())
+		return spec
+	case streaming_connection.TunnelProtocolTcp, streaming_connection.TunnelProtocolTls:
+		spec.Handler = NewTcpForwarder(logger, d.LocalAddress())
+		return spec
+	}
+
+	options := HttpProxyOptions{
+		HostHeader: d.HostHeader,
+		BasicAuth:  d.Auth,
+		Inspector:  inspector,
+	}
+	if d.RequestHeaders != nil {
+		options.RequestHeaders = *d.RequestHeaders
+	}
+	if d.ResponseHeaders != nil {
+		options.ResponseHeaders = *d.ResponseHeaders
+	}
+	spec.Handler = NewHttpProxy(logger, d.upstream(), options)
+	return spec
+}
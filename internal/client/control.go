@@ -0,0 +1,269 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+/*
+ * A detached agent serves a small HTTP API on a unix socket that yukactl uses to manage it:
+ *
+ *   GET  /status                    state of the tunnel, see DaemonStatus
+ *   POST /stop                      shuts the agent down once in-flight requests have drained
+ *   GET  /logs?lines=100&follow=1   the last lines of the log file, followed by new lines as they're written
+ **/
+
+const (
+	defaultLogLines = 100
+	// logFollowInterval is how often the log file is checked for new lines while following
+	logFollowInterval      = 500 * time.Millisecond
+	controlShutdownTimeout = 5 * time.Second
+)
+
+// ControlServer serves the control API of a detached agent
+type ControlServer struct {
+	slogger    *zap.SugaredLogger
+	paths      *DaemonPaths
+	tunnel     *Tunnel
+	stop       context.CancelFunc
+	startedAt  time.Time
+	shutdownCh chan struct{}
+}
+
+func NewControlServer(logger *zap.Logger, paths *DaemonPaths, tunnel *Tunnel, stop context.CancelFunc) *ControlServer {
+	return &ControlServer{
+		slogger:    logger.Sugar(),
+		paths:      paths,
+		tunnel:     tunnel,
+		stop:       stop,
+		startedAt:  time.Now(),
+		shutdownCh: make(chan struct{}),
+	}
+}
+
+// Listen is a blocking call that serves the control API on the unix socket
+//
+// Will close on ctx.Done() being called
+func (self *ControlServer) Listen(ctx context.Context) error {
+	// A socket left behind by an agent that crashed would stop us listening
+	os.Remove(self.paths.SocketFile)
+	listener, err := net.Listen("unix", self.paths.SocketFile)
+	if err != nil {
+		return fmt.Errorf("unable to listen on control socket: %v", err)
+	}
+	if err := os.Chmod(self.paths.SocketFile, 0600); err != nil {
+		listener.Close()
+		return fmt.Errorf("unable to restrict access to control socket: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /status", self.handleStatus)
+	mux.HandleFunc("POST /stop", self.handleStop)
+	mux.HandleFunc("GET /logs", self.handleLogs)
+	server := &http.Server{Handler: mux}
+
+	go func() {
+		<-ctx.Done()
+		close(self.shutdownCh)
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), controlShutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			self.slogger.Warnf("Error shutting down control server: %v", err)
+		}
+	}()
+
+	self.slogger.Infof("Control server listening on %s", self.paths.SocketFile)
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (self *ControlServer) handleStatus(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, &DaemonStatus{
+		Pid:       os.Getpid(),
+		State:     self.tunnel.State().String(),
+		LatencyMs: self.tunnel.Latency().Milliseconds(),
+		StartedAt: self.startedAt,
+		Tunnels:   self.tunnel.Assignments(),
+	})
+}
+
+func (self *ControlServer) handleStop(w http.ResponseWriter, r *http.Request) {
+	self.slogger.Info("Received stop request")
+	w.WriteHeader(http.StatusAccepted)
+	self.stop()
+}
+
+func (self *ControlServer) handleLogs(w http.ResponseWriter, r *http.Request) {
+	lines := defaultLogLines
+	if s := r.URL.Query().Get("lines"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			http.Error(w, "lines must be a positive number", http.StatusBadRequest)
+			return
+		}
+		lines = n
+	}
+	follow, _ := strconv.ParseBool(r.URL.Query().Get("follow"))
+
+	f, err := os.Open(self.paths.LogFile)
+	if err != nil {
+		self.slogger.Warnf("Unable to open log file: %v", err)
+		http.Error(w, "unable to open log file", http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	for _, line := range tailLines(f, lines) {
+		fmt.Fprintln(w, line)
+	}
+	if !follow {
+		return
+	}
+
+	flusher, _ := w.(http.Flusher)
+	ticker := time.NewTicker(logFollowInterval)
+	defer ticker.Stop()
+	for {
+		if flusher != nil {
+			flusher.Flush()
+		}
+		select {
+		case <-r.Context().Done():
+			return
+		case <-self.shutdownCh:
+			return
+		case <-ticker.C:
+		}
+		// f is left at the end of what has been sent so far, anything after it is new
+		if _, err := io.Copy(w, f); err != nil {
+			return
+		}
+	}
+}
+
+// tailLines returns the last n lines of r, leaving r at its end
+func tailLines(r io.Reader, n int) []string {
+	lines := make([]string, 0, n)
+	if n == 0 {
+		io.Copy(io.Discard, r)
+		return lines
+	}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if len(lines) == n {
+			lines = lines[1:]
+		}
+		lines = append(lines, scanner.Text())
+	}
+	return lines
+}
+
+// ControlClient calls the control API of a detached agent
+type ControlClient struct {
+	paths      *DaemonPaths
+	httpClient *http.Client
+}
+
+func NewControlClient(paths *DaemonPaths) *ControlClient {
+	dialer := net.Dialer{Timeout: dialTimeout}
+	return &ControlClient{
+		paths: paths,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return dialer.DialContext(ctx, "unix", paths.SocketFile)
+				},
+			},
+		},
+	}
+}
+
+// Status returns the status of the detached agent, ErrDaemonNotRunning is returned if it isn't running
+func (self *ControlClient) Status(ctx context.Context) (*DaemonStatus, error) {
+	resp, err := self.do(ctx, http.MethodGet, "/status", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var status DaemonStatus
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return nil, fmt.Errorf("invalid status response: %v", err)
+	}
+	return &status, nil
+}
+
+// Stop asks the detached agent to shut down, it returns before the agent has exited
+func (self *ControlClient) Stop(ctx context.Context) error {
+	resp, err := self.do(ctx, http.MethodPost, "/stop", nil)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// Logs writes the last lines of the detached agent's logs to w. If follow is set new lines keep being
+// written until ctx is cancelled or the agent stops.
+func (self *ControlClient) Logs(ctx context.Context, w io.Writer, lines int, follow bool) error {
+	query := url.Values{}
+	query.Set("lines", strconv.Itoa(lines))
+	query.Set("follow", strconv.FormatBool(follow))
+	resp, err := self.do(ctx, http.MethodGet, "/logs", query)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if _, err := io.Copy(w, resp.Body); err != nil && ctx.Err() == nil {
+		return err
+	}
+	return nil
+}
+
+func (self *ControlClient) do(ctx context.Context, method string, path string, query url.Values) (*http.Response, error) {
+	u := url.URL{Scheme: "http", Host: "yukactl", Path: path, RawQuery: query.Encode()}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := self.httpClient.Do(req)
+	if err != nil {
+		if isConnectionRefused(err) {
+			return nil, ErrDaemonNotRunning
+		}
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		defer resp.Body.Close()
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("control request %s %s failed with status %d: %s", method, path, resp.StatusCode, b)
+	}
+	return resp, nil
+}
+
+// isConnectionRefused checks if err is from dialing a socket nothing is listening on
+func isConnectionRefused(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+	"yuka/pkg/streaming_connection"
+	"yuka/pkg/utils"
+
+	"go.uber.org/zap"
+)
+
+const (
+	pidFileName    = "yukactl.pid"
+	socketFileName = "yukactl.sock"
+	logFileName    = "yukactl.log"
+
+	// daemonStartTimeout is how long start waits for a detached agent to answer on its control socket
+	daemonStartTimeout = 10 * time.Second
+	// daemonStopTimeout is how long stop waits for the agent to drain its requests and exit
+	daemonStopTimeout = drainTimeout + 5*time.Second
+)
+
+var (
+	ErrDaemonNotRunning     = errors.New("yukactl is not running in detached mode")
+	ErrDaemonAlreadyRunning = errors.New("yukactl is already running in detached mode")
+)
+
+// DaemonPaths are the files used by an agent running in detached mode
+type DaemonPaths struct {
+	// PidFile holds the pid of the detached agent
+	PidFile string
+	// SocketFile is the unix socket the agent serves its control API on
+	SocketFile string
+	// LogFile is where the agent writes its logs
+	LogFile string
+}
+
+// NewDaemonPaths returns the paths of the detached agent's files within dir
+func NewDaemonPaths(dir string) *DaemonPaths {
+	return &DaemonPaths{
+		PidFile:    filepath.Join(dir, pidFileName),
+		SocketFile: filepath.Join(dir, socketFileName),
+		LogFile:    filepath.Join(dir, logFileName),
+	}
+}
+
+// DefaultDaemonPaths returns the paths of the detached agent's files within $HOME/.yuka
+func DefaultDaemonPaths() (*DaemonPaths, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("unable to find home directory: %v", err)
+	}
+	return NewDaemonPaths(filepath.Join(home, ".yuka")), nil
+}
+
+// Remove removes the pidfile and control socket
+func (p *DaemonPaths) Remove() {
+	os.Remove(p.PidFile)
+	os.Remove(p.SocketFile)
+}
+
+// DaemonStatus is returned by the detached agent's control API
+type DaemonStatus struct {
+	Pid       int                                     `json:"pid"`
+	State     string                                  `json:"state"`
+	LatencyMs int64                                   `json:"latencyMs"`
+	StartedAt time.Time                               `json:"startedAt"`
+	Tunnels   []streaming_connection.TunnelAssignment `json:"tunnels"`
+}
+
+// Detach starts a copy of the running command in the background with args and waits for it to come up
+// on the control socket. Its output is appended to the log file.
+func Detach(logger *zap.Logger, paths *DaemonPaths, args []string) (int, error) {
+	slogger := logger.Sugar()
+	if status, err := NewControlClient(paths).Status(context.Background()); err == nil {
+		return 0, fmt.Errorf("%w with pid %d", ErrDaemonAlreadyRunning, status.Pid)
+	}
+	// Anything left over is from an agent that didn't shut down cleanly
+	paths.Remove()
+
+	if err := utils.CreateDirectoriesForPath(slogger, filepath.Dir(paths.LogFile)); err != nil {
+		return 0, err
+	}
+	logFile, err := os.OpenFile(paths.LogFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return 0, fmt.Errorf("unable to open log file: %v", err)
+	}
+	defer logFile.Close()
+
+	executable, err := os.Executable()
+	if err != nil {
+		return 0, fmt.Errorf("unable to find executable: %v", err)
+	}
+	cmd := exec.Command(executable, args...)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	cmd.SysProcAttr = detachedProcAttr()
+	if err := cmd.Start(); err != nil {
+		return 0, fmt.Errorf("unable to start detached agent: %v", err)
+	}
+	pid := cmd.Process.Pid
+
+	// Reap the agent if it exits before it's up so we can report it
+	exited := make(chan error, 1)
+	go func() {
+		exited <- cmd.Wait()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonStartTimeout)
+	defer cancel()
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case err := <-exited:
+			return 0, fmt.Errorf("detached agent exited, see %s: %v", paths.LogFile, err)
+		case <-ctx.Done():
+			return pid, fmt.Errorf("timed out waiting for detached agent with pid %d to start, see %s", pid, paths.LogFile)
+		case <-ticker.C:
+			if _, err := NewControlClient(paths).Status(ctx); err == nil {
+				cmd.Process.Release()
+				return pid, nil
+			}
+		}
+	}
+}
+
+// RunDaemon writes the pidfile and serves the control API for tunnel until ctx is cancelled. Calling the
+// stop endpoint calls stop. The pidfile and socket are removed on return.
+func RunDaemon(ctx context.Context, logger *zap.Logger, paths *DaemonPaths, tunnel *Tunnel, stop context.CancelFunc) error {
+	slogger := logger.Sugar()
+	if err := utils.WriteToFile(slogger, strconv.Itoa(os.Getpid()), paths.PidFile, 0600); err != nil {
+		return fmt.Errorf("unable to write pidfile: %v", err)
+	}
+	defer paths.Remove()
+
+	server := NewControlServer(logger, paths, tunnel, stop)
+	return server.Listen(ctx)
+}
+
+// StopDaemon asks the detached agent to shut down and waits for it to exit
+func StopDaemon(ctx context.Context, paths *DaemonPaths) (int, error) {
+	controlClient := NewControlClient(paths)
+	status, err := controlClient.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := controlClient.Stop(ctx); err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, daemonStopTimeout)
+	defer cancel()
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for processRunning(status.Pid) {
+		select {
+		case <-ctx.Done():
+			return status.Pid, fmt.Errorf("timed out waiting for pid %d to exit", status.Pid)
+		case <-ticker.C:
+		}
+	}
+	return status.Pid, nil
+}
@@ -0,0 +1,19 @@
+//go:build !windows
+
+package client
+
+import (
+	"errors"
+	"syscall"
+)
+
+// detachedProcAttr starts the agent in a new session so it isn't killed when the terminal closes
+func detachedProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setsid: true}
+}
+
+// processRunning checks if a process with pid exists
+func processRunning(pid int) bool {
+	err := syscall.Kill(pid, 0)
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
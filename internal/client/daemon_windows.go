@@ -0,0 +1,24 @@
+package client
+
+import (
+	"os"
+	"syscall"
+)
+
+// detachedProcess starts the process without a console
+const detachedProcess = 0x00000008
+
+// detachedProcAttr starts the agent without a console so it isn't killed when the terminal closes
+func detachedProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP | detachedProcess}
+}
+
+// processRunning checks if a process with pid exists
+func processRunning(pid int) bool {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	process.Release()
+	return true
+}
@@ -0,0 +1,465 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"yuka/pkg/http_helper"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultInspectorAddress is where the inspector UI and API are served
+	DefaultInspectorAddress = "127.0.0.1:4040"
+	// DefaultInspectorCapacity is the number of exchanges kept before the oldest are dropped
+	DefaultInspectorCapacity = 200
+	// DefaultInspectorMaxBodySize is the most of each request and response body that is kept
+	DefaultInspectorMaxBodySize = 64 * 1024
+
+	inspectorShutdownTimeout = 5 * time.Second
+)
+
+var (
+	ErrExchangeNotFound     = errors.New("exchange not found")
+	ErrBodyNotReplayable    = errors.New("request body was truncated when it was captured, give a body to replay it with")
+	ErrUpgradeNotReplayable = errors.New("requests that upgrade the connection can't be replayed")
+)
+
+//go:embed inspector.html
+var inspectorPage []byte
+
+// CapturedRequest is a request captured by the Inspector as it was received from the server
+type CapturedRequest struct {
+	Method string      `json:"method"`
+	URI    string      `json:"uri"`
+	Host   string      `json:"host"`
+	Proto  string      `json:"proto"`
+	Header http.Header `json:"header"`
+	CapturedBody
+}
+
+// CapturedResponse is a response captured by the Inspector as it was sent back to the server
+type CapturedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header"`
+	CapturedBody
+}
+
+// CapturedBody is the start of a request or response body
+type CapturedBody struct {
+	Body []byte `json:"body"`
+	// BodySize is the full size of the body, more than len(Body) when it was truncated
+	BodySize      int64 `json:"bodySize"`
+	BodyTruncated bool  `json:"bodyTruncated"`
+}
+
+// CapturedExchange is a request and its response that flowed through an HTTP tunnel
+type CapturedExchange struct {
+	ID uint64 `json:"id"`
+	// ReplayOf is the id of the exchange this one replayed, zero if it came through the tunnel
+	ReplayOf  uint64            `json:"replayOf,omitempty"`
+	StartedAt time.Time         `json:"startedAt"`
+	Request   CapturedRequest   `json:"request"`
+	Response  *CapturedResponse `json:"response,omitempty"`
+	// HeadersMs is how long the upstream took to send the response headers
+	HeadersMs float64 `json:"headersMs"`
+	// DurationMs is how long it took to proxy the whole exchange
+	DurationMs float64 `json:"durationMs"`
+	Completed  bool    `json:"completed"`
+	Error      string  `json:"error,omitempty"`
+
+	proxy *HttpProxy
+}
+
+// clone copies the exchange so it can be read while the original is still being captured
+func (e *CapturedExchange) clone() CapturedExchange {
+	copied := *e
+	if e.Response != nil {
+		response := *e.Response
+		copied.Response = &response
+	}
+	return copied
+}
+
+// ReplayRequest are the edits made to a captured request before it's replayed, the captured value is
+// used for anything that isn't set
+type ReplayRequest struct {
+	Method string      `json:"method,omitempty"`
+	URI    string      `json:"uri,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+	Body   *string     `json:"body,omitempty"`
+}
+
+// Inspector captures the exchanges flowing through HTTP tunnels in a bounded ring buffer and serves them
+// to a local UI, from which they can be replayed
+type Inspector struct {
+	slogger     *zap.SugaredLogger
+	maxBodySize int64
+
+	lock      sync.Mutex
+	exchanges []*CapturedExchange
+	// next is the index in exchanges the next capture is written to
+	next   int
+	lastID uint64
+}
+
+func NewInspector(logger *zap.Logger, capacity int, maxBodySize int64) *Inspector {
+	return &Inspector{
+		slogger:     logger.Sugar(),
+		maxBodySize: maxBodySize,
+		exchanges:   make([]*CapturedExchange, 0, capacity),
+	}
+}
+
+// Exchanges returns a copy of the captured exchanges, newest first
+func (self *Inspector) Exchanges() []CapturedExchange {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	exchanges := make([]CapturedExchange, 0, len(self.exchanges))
+	for i := 1; i <= len(self.exchanges); i++ {
+		index := (self.next - i + len(self.exchanges)) % len(self.exchanges)
+		exchanges = append(exchanges, self.exchanges[index].clone())
+	}
+	return exchanges
+}
+
+// Exchange returns a copy of the exchange with id
+func (self *Inspector) Exchange(id uint64) (*CapturedExchange, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	exchange := self.find(id)
+	if exchange == nil {
+		return nil, ErrExchangeNotFound
+	}
+	copied := exchange.clone()
+	return &copied, nil
+}
+
+// Clear removes every captured exchange
+func (self *Inspector) Clear() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	clear(self.exchanges)
+	self.exchanges = self.exchanges[:0]
+	self.next = 0
+}
+
+// Replay sends the request of the exchange with id to the local service again, with edits applied if
+// they're given. The replayed exchange is captured and returned.
+func (self *Inspector) Replay(id uint64, edits *ReplayRequest) (*CapturedExchange, error) {
+	original, err := self.Exchange(id)
+	if err != nil {
+		return nil, err
+	}
+	if edits == nil {
+		edits = &ReplayRequest{}
+	}
+
+	method, uri, header, body := original.Request.Method, original.Request.URI, original.Request.Header.Clone(), original.Request.Body
+	if edits.Method != "" {
+		method = edits.Method
+	}
+	if edits.URI != "" {
+		uri = edits.URI
+	}
+	if edits.Header != nil {
+		header = edits.Header.Clone()
+	}
+	if edits.Body != nil {
+		body = []byte(*edits.Body)
+	} else if original.Request.BodyTruncated {
+		return nil, ErrBodyNotReplayable
+	}
+	if http_helper.UpgradeType(header) != "" {
+		return nil, ErrUpgradeNotReplayable
+	}
+
+	// The upstream is filled in by the proxy
+	req, err := http.NewRequest(method, "http://"+original.Request.Host+uri, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("invalid replay request: %v", err)
+	}
+	req.Header = header
+	req.Header.Del("Content-Length")
+	req.Host = original.Request.Host
+
+	capture := self.capture(original.proxy, req, id)
+	resp := original.proxy.roundTrip(req)
+	capture.response(resp)
+	_, err = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	capture.finish(err)
+
+	return self.Exchange(capture.exchange.ID)
+}
+
+// Listen is a blocking call that serves the inspector UI and API on address, which must be a loopback
+// address as captured requests include their credentials
+//
+// Will close on ctx.Done() being called
+func (self *Inspector) Listen(ctx context.Context, address string) error {
+	if !isLoopbackAddress(address) {
+		return fmt.Errorf("inspector address %s is not a loopback address", address)
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("unable to listen on %s: %v", address, err)
+	}
+	server := &http.Server{Handler: self.handler(listener.Addr().String())}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), inspectorShutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			self.slogger.Warnf("Error shutting down inspector: %v", err)
+		}
+	}()
+
+	self.slogger.Infof("Inspecting requests on http://%s", listener.Addr())
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// handler serves the inspector UI and API to requests sent to address, the address it's listening on
+func (self *Inspector) handler(address string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", self.handlePage)
+	mux.HandleFunc("GET /api/requests", self.handleList)
+	mux.HandleFunc("DELETE /api/requests", self.handleClear)
+	mux.HandleFunc("GET /api/requests/{id}", self.handleGet)
+	mux.HandleFunc("POST /api/requests/{id}/replay", self.handleReplay)
+	return checkInspectorRequest(address, mux)
+}
+
+// checkInspectorRequest only lets through requests made by the inspector UI. The Host has to be the address
+// the inspector listens on so pages can't read captured requests by rebinding their domain to it, and
+// changes have to be sent as JSON from the inspector's own origin so pages can't make them cross-site.
+func checkInspectorRequest(address string, next http.Handler) http.Handler {
+	hosts := []string{address}
+	if _, port, err := net.SplitHostPort(address); err == nil {
+		hosts = append(hosts, net.JoinHostPort("localhost", port))
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !slices.Contains(hosts, strings.ToLower(r.Host)) {
+			writeJSON(w, http.StatusForbidden, map[string]string{"error": fmt.Sprintf("invalid host %q", r.Host)})
+			return
+		}
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			if origin := r.Header.Get("Origin"); origin != "" && origin != "http://"+r.Host {
+				writeJSON(w, http.StatusForbidden, map[string]string{"error": fmt.Sprintf("invalid origin %q", origin)})
+				return
+			}
+			if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/json" {
+				writeJSON(w, http.StatusUnsupportedMediaType, map[string]string{"error": "content type must be application/json"})
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isLoopbackAddress returns true if the host of address is localhost or a loopback IP
+func isLoopbackAddress(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func (self *Inspector) handlePage(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(inspectorPage)
+}
+
+func (self *Inspector) handleList(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, self.Exchanges())
+}
+
+func (self *Inspector) handleClear(w http.ResponseWriter, r *http.Request) {
+	self.Clear()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (self *Inspector) handleGet(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid id"})
+		return
+	}
+	exchange, err := self.Exchange(id)
+	if err != nil {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, exchange)
+}
+
+func (self *Inspector) handleReplay(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid id"})
+		return
+	}
+	var edits ReplayRequest
+	if err := json.NewDecoder(r.Body).Decode(&edits); err != nil && !errors.Is(err, io.EOF) {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": fmt.Sprintf("invalid replay request: %v", err)})
+		return
+	}
+
+	exchange, err := self.Replay(id, &edits)
+	switch {
+	case errors.Is(err, ErrExchangeNotFound):
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": err.Error()})
+	case err != nil:
+		writeJSON(w, http.StatusUnprocessableEntity, map[string]string{"error": err.Error()})
+	default:
+		writeJSON(w, http.StatusOK, exchange)
+	}
+}
+
+// capture starts capturing req, which is being proxied by proxy. Its body is captured as it's read.
+func (self *Inspector) capture(proxy *HttpProxy, req *http.Request, replayOf uint64) *exchangeCapture {
+	capture := &exchangeCapture{
+		inspector: self,
+		exchange: &CapturedExchange{
+			ReplayOf:  replayOf,
+			StartedAt: time.Now(),
+			Request: CapturedRequest{
+				Method: req.Method,
+				URI:    req.URL.RequestURI(),
+				Host:   req.Host,
+				Proto:  req.Proto,
+				// The proxy rewrites the headers so they're copied as they were received
+				Header: req.Header.Clone(),
+			},
+			proxy: proxy,
+		},
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		capture.requestBody = newBodyCapture(req.Body, self.maxBodySize)
+		req.Body = capture.requestBody
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.lastID++
+	capture.exchange.ID = self.lastID
+	if len(self.exchanges) < cap(self.exchanges) {
+		self.exchanges = append(self.exchanges, capture.exchange)
+	} else {
+		self.exchanges[self.next] = capture.exchange
+	}
+	self.next = (self.next + 1) % cap(self.exchanges)
+	return capture
+}
+
+func (self *Inspector) find(id uint64) *CapturedExchange {
+	for _, exchange := range self.exchanges {
+		if exchange.ID == id {
+			return exchange
+		}
+	}
+	return nil
+}
+
+// exchangeCapture fills in a CapturedExchange as it's proxied
+type exchangeCapture struct {
+	inspector    *Inspector
+	exchange     *CapturedExchange
+	requestBody  *bodyCapture
+	responseBody *bodyCapture
+}
+
+// response records the headers of resp, its body is captured as it's read
+func (c *exchangeCapture) response(resp *http.Response) {
+	c.responseBody = newBodyCapture(resp.Body, c.inspector.maxBodySize)
+	resp.Body = c.responseBody
+
+	c.inspector.lock.Lock()
+	defer c.inspector.lock.Unlock()
+	c.exchange.HeadersMs = msSince(c.exchange.StartedAt)
+	c.exchange.Response = &CapturedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+	}
+}
+
+// finish records the bodies once the response has been sent, err is the error sending it if any
+func (c *exchangeCapture) finish(err error) {
+	c.inspector.lock.Lock()
+	defer c.inspector.lock.Unlock()
+	c.exchange.DurationMs = msSince(c.exchange.StartedAt)
+	c.exchange.Completed = true
+	if err != nil {
+		c.exchange.Error = err.Error()
+	}
+	if c.requestBody != nil {
+		c.exchange.Request.CapturedBody = c.requestBody.captured()
+	}
+	if c.responseBody != nil && c.exchange.Response != nil {
+		c.exchange.Response.CapturedBody = c.responseBody.captured()
+	}
+}
+
+// bodyCapture keeps up to limit bytes of a body as it's read
+type bodyCapture struct {
+	io.ReadCloser
+	limit int64
+
+	lock sync.Mutex
+	buf  bytes.Buffer
+	size int64
+}
+
+func newBodyCapture(body io.ReadCloser, limit int64) *bodyCapture {
+	return &bodyCapture{ReadCloser: body, limit: limit}
+}
+
+func (b *bodyCapture) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.size += int64(n)
+	if remaining := b.limit - int64(b.buf.Len()); remaining > 0 {
+		b.buf.Write(p[:min(int64(n), remaining)])
+	}
+	return n, err
+}
+
+func (b *bodyCapture) captured() CapturedBody {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return CapturedBody{
+		Body:          bytes.Clone(b.buf.Bytes()),
+		BodySize:      b.size,
+		BodyTruncated: b.size > int64(b.buf.Len()),
+	}
+}
+
+func msSince(t time.Time) float64 {
+	return float64(time.Since(t).Microseconds()) / 1000
+}
@@ -0,0 +1,202 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"yuka/pkg/streaming_connection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newInspectedProxy returns a proxy to an upstream echoing the method, header X-Test and body of requests,
+// capturing every exchange in an inspector
+func newInspectedProxy(t *testing.T, capacity int, maxBodySize int64) (*HttpProxy, *Inspector) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("X-Test", r.Header.Get("X-Test"))
+		io.WriteString(w, r.Method+" "+string(body))
+	}))
+	t.Cleanup(upstream.Close)
+	upstreamURL, err := url.Parse(upstream.URL)
+	require.NoError(t, err)
+
+	inspector := NewInspector(zap.NewNop(), capacity, maxBodySize)
+	return NewHttpProxy(zap.NewNop(), upstreamURL, HttpProxyOptions{Inspector: inspector}), inspector
+}
+
+// sendThroughTunnel sends req to proxy on a stream the way the server does and returns the response body
+// once the proxy is done with the stream, so the exchange has been captured
+func sendThroughTunnel(t *testing.T, proxy *HttpProxy, req *http.Request) string {
+	serverConn, agentConn := net.Pipe()
+	server := streaming_connection.NewMuxSession(zap.NewNop(), serverConn, false, nil)
+	agent := streaming_connection.NewMuxSession(zap.NewNop(), agentConn, true, nil)
+	defer server.Close()
+	defer agent.Close()
+	served := make(chan struct{})
+	go func() {
+		defer close(served)
+		if stream, err := agent.AcceptStream(); err == nil {
+			proxy.ServeStream(stream)
+		}
+	}()
+
+	stream, err := server.OpenStream()
+	require.NoError(t, err)
+	defer stream.Close()
+	require.NoError(t, req.Write(stream))
+	require.NoError(t, stream.CloseWrite())
+	resp, err := http.ReadResponse(bufio.NewReader(stream), req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	<-served
+	return string(body)
+}
+
+func newTunneledRequest(t *testing.T, method string, body string) *http.Request {
+	req, err := http.NewRequest(method, "http://foo.yuka.dev/path?q=1", strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("X-Test", "captured")
+	return req
+}
+
+func TestInspectorCapturesExchanges(t *testing.T) {
+	proxy, inspector := newInspectedProxy(t, 10, 1024)
+
+	assert.Equal(t, "POST hello", sendThroughTunnel(t, proxy, newTunneledRequest(t, http.MethodPost, "hello")))
+
+	exchanges := inspector.Exchanges()
+	require.Len(t, exchanges, 1)
+	exchange := exchanges[0]
+	assert.Equal(t, uint64(1), exchange.ID)
+	assert.True(t, exchange.Completed)
+	assert.Equal(t, http.MethodPost, exchange.Request.Method)
+	assert.Equal(t, "/path?q=1", exchange.Request.URI)
+	assert.Equal(t, "foo.yuka.dev", exchange.Request.Host)
+	assert.Equal(t, "captured", exchange.Request.Header.Get("X-Test"))
+	assert.Equal(t, "hello", string(exchange.Request.Body))
+	require.NotNil(t, exchange.Response)
+	assert.Equal(t, http.StatusOK, exchange.Response.StatusCode)
+	assert.Equal(t, "captured", exchange.Response.Header.Get("X-Test"))
+	assert.Equal(t, "POST hello", string(exchange.Response.Body))
+	assert.False(t, exchange.Response.BodyTruncated)
+}
+
+func TestInspectorEvictsOldestExchanges(t *testing.T) {
+	proxy, inspector := newInspectedProxy(t, 2, 1024)
+	for _, body := range []string{"one", "two", "three"} {
+		sendThroughTunnel(t, proxy, newTunneledRequest(t, http.MethodPost, body))
+	}
+
+	exchanges := inspector.Exchanges()
+	require.Len(t, exchanges, 2)
+	assert.Equal(t, uint64(3), exchanges[0].ID)
+	assert.Equal(t, "three", string(exchanges[0].Request.Body))
+	assert.Equal(t, uint64(2), exchanges[1].ID)
+	_, err := inspector.Exchange(1)
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	inspector.Clear()
+	assert.Empty(t, inspector.Exchanges())
+}
+
+func TestInspectorTruncatesBodies(t *testing.T) {
+	proxy, inspector := newInspectedProxy(t, 10, 4)
+
+	// The whole body is proxied even though only the start of it is kept
+	assert.Equal(t, "POST hello world", sendThroughTunnel(t, proxy, newTunneledRequest(t, http.MethodPost, "hello world")))
+
+	exchange, err := inspector.Exchange(1)
+	require.NoError(t, err)
+	assert.Equal(t, "hell", string(exchange.Request.Body))
+	assert.Equal(t, int64(11), exchange.Request.BodySize)
+	assert.True(t, exchange.Request.BodyTruncated)
+	assert.Equal(t, "POST", string(exchange.Response.Body))
+	assert.Equal(t, int64(16), exchange.Response.BodySize)
+	assert.True(t, exchange.Response.BodyTruncated)
+
+	// The truncated body can't be sent again so a replay needs one to be given
+	_, err = inspector.Replay(1, nil)
+	assert.ErrorIs(t, err, ErrBodyNotReplayable)
+	body := "bye"
+	replayed, err := inspector.Replay(1, &ReplayRequest{Body: &body})
+	require.NoError(t, err)
+	assert.Equal(t, "bye", string(replayed.Request.Body))
+	assert.Equal(t, int64(8), replayed.Response.BodySize)
+}
+
+func TestInspectorReplaysExchanges(t *testing.T) {
+	proxy, inspector := newInspectedProxy(t, 10, 1024)
+	sendThroughTunnel(t, proxy, newTunneledRequest(t, http.MethodPost, "hello"))
+
+	replayed, err := inspector.Replay(1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), replayed.ID)
+	assert.Equal(t, uint64(1), replayed.ReplayOf)
+	assert.Equal(t, "POST hello", string(replayed.Response.Body))
+	assert.Equal(t, "captured", replayed.Response.Header.Get("X-Test"))
+
+	body := "edited"
+	replayed, err = inspector.Replay(1, &ReplayRequest{Method: http.MethodPut, Header: http.Header{"X-Test": {"changed"}}, Body: &body})
+	require.NoError(t, err)
+	assert.Equal(t, "PUT edited", string(replayed.Response.Body))
+	assert.Equal(t, "changed", replayed.Response.Header.Get("X-Test"))
+	assert.Len(t, inspector.Exchanges(), 3)
+
+	_, err = inspector.Replay(42, nil)
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+}
+
+func TestInspectorOnlyAnswersItsOwnPage(t *testing.T) {
+	proxy, inspector := newInspectedProxy(t, 10, 1024)
+	sendThroughTunnel(t, proxy, newTunneledRequest(t, http.MethodPost, "hello"))
+	handler := inspector.handler("127.0.0.1:4040")
+
+	serve := func(method string, host string, header http.Header, body string) int {
+		req := httptest.NewRequest(method, "http://"+host+"/api/requests/1/replay", strings.NewReader(body))
+		if method == http.MethodGet {
+			req = httptest.NewRequest(method, "http://"+host+"/api/requests/1", nil)
+		}
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		return recorder.Code
+	}
+	json := http.Header{"Content-Type": {"application/json"}}
+
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "127.0.0.1:4040", nil, ""))
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "localhost:4040", nil, ""))
+	// Pages rebinding their own domain to the inspector can't read captured requests
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodGet, "attacker.example.com:4040", nil, ""))
+
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "127.0.0.1:4040", json, "{}"))
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "127.0.0.1:4040", http.Header{
+		"Content-Type": {"application/json"},
+		"Origin":       {"http://127.0.0.1:4040"},
+	}, "{}"))
+	// Simple cross-site requests can't trigger a replay
+	assert.Equal(t, http.StatusUnsupportedMediaType, serve(http.MethodPost, "127.0.0.1:4040", http.Header{"Content-Type": {"text/plain"}}, "{}"))
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodPost, "127.0.0.1:4040", http.Header{
+		"Content-Type": {"application/json"},
+		"Origin":       {"http://attacker.example.com"},
+	}, "{}"))
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodPost, "attacker.example.com:4040", json, "{}"))
+}
+
+func TestInspectorOnlyListensOnLoopback(t *testing.T) {
+	inspector := NewInspector(zap.NewNop(), 10, 1024)
+	assert.ErrorContains(t, inspector.Listen(context.Background(), "0.0.0.0:0"), "not a loopback address")
+	assert.ErrorContains(t, inspector.Listen(context.Background(), ":0"), "not a loopback address")
+}
@@ -0,0 +1,340 @@
+package client
+
+import (
+	"bufio"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"yuka/pkg/http_helper"
+	"yuka/pkg/streaming_connection"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// HostHeaderRewrite sets the Host header of proxied requests to the upstream address
+	HostHeaderRewrite = "rewrite"
+)
+
+// StreamHandler serves the streams opened by the server on a Tunnel
+type StreamHandler interface {
+	// ServeStream handles stream until it is finished with, closing it before returning
+	ServeStream(stream streaming_connection.Stream)
+}
+
+// TcpForwarder copies the raw bytes of every stream to and from a new connection to address
+type TcpForwarder struct {
+	slogger *zap.SugaredLogger
+	address string
+}
+
+func NewTcpForwarder(logger *zap.Logger, address string) *TcpForwarder {
+	return &TcpForwarder{
+		slogger: logger.Sugar(),
+		address: address,
+	}
+}
+
+// ServeStream dials the address and copies data between it and the stream
+func (self *TcpForwarder) ServeStream(stream streaming_connection.Stream) {
+	forwardConn, err := net.Dial("tcp", self.address)
+	if err != nil {
+		self.slogger.Errorf("Error occurred when dialing connection: %v", err)
+		stream.Close()
+		return
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		self.slogger.Debugf("Forwarding data from forwardConn to stream %d", stream.ID())
+		if _, err := io.Copy(stream, forwardConn); err != nil {
+			self.slogger.Errorf("Error copying from forwardConn to stream: %v", err)
+		}
+		stream.CloseWrite()
+	}()
+	go func() {
+		defer wg.Done()
+		self.slogger.Debugf("Forwarding data from stream %d to forwardConn", stream.ID())
+		if _, err := io.Copy(forwardConn, stream); err != nil {
+			self.slogger.Errorf("Error copying from stream to forwardConn: %v", err)
+		}
+		streaming_connection.CloseWrite(forwardConn)
+	}()
+
+	wg.Wait()
+	forwardConn.Close()
+	stream.Close()
+}
+
+// UdpForwarder sends the datagrams carried by every stream to address from a socket of the stream's own, so
+// replies from the service are sent back to the remote peer the stream is for
+type UdpForwarder struct {
+	slogger *zap.SugaredLogger
+	address string
+}
+
+func NewUdpForwarder(logger *zap.Logger, address string) *UdpForwarder {
+	return &UdpForwarder{
+		slogger: logger.Sugar(),
+		address: address,
+	}
+}
+
+// ServeStream dials the address and copies datagrams between it and the stream until the server closes the
+// stream, which it does once the remote peer has gone idle
+func (self *UdpForwarder) ServeStream(stream streaming_connection.Stream) {
+	forwardConn, err := net.Dial("udp", self.address)
+	if err != nil {
+		self.slogger.Errorf("Error occurred when dialing connection: %v", err)
+		stream.Close()
+		return
+	}
+
+	go func() {
+		buf := make([]byte, streaming_connection.MaxDatagramSize)
+		for {
+			n, err := forwardConn.Read(buf)
+			if errors.Is(err, syscall.ECONNREFUSED) {
+				// An earlier datagram was refused as nothing was listening, the service may have started since
+				continue
+			}
+			if err != nil {
+				if !errors.Is(err, net.ErrClosed) {
+					self.slogger.Errorf("Error reading datagram from forwardConn: %v", err)
+				}
+				stream.Close()
+				return
+			}
+			if err := streaming_connection.WriteDatagram(stream, buf[:n]); err != nil {
+				self.slogger.Errorf("Error writing datagram to stream: %v", err)
+				return
+			}
+		}
+	}()
+
+	self.slogger.Debugf("Forwarding datagrams from stream %d to forwardConn", stream.ID())
+	buf := make([]byte, streaming_connection.MaxDatagramSize)
+	for {
+		n, err := streaming_connection.ReadDatagram(stream, buf)
+		if err != nil {
+			if err != io.EOF {
+				self.slogger.Errorf("Error reading datagram from stream: %v", err)
+			}
+			break
+		}
+		if _, err := forwardConn.Write(buf[:n]); err != nil {
+			// The service may not be listening yet, like a UDP socket the datagram is just lost
+			self.slogger.Debugf("Error writing datagram to forwardConn: %v", err)
+		}
+	}
+	forwardConn.Close()
+	stream.Close()
+}
+
+// BasicAuth are the credentials public requests must have to be proxied
+type BasicAuth struct {
+	Username string `mapstructure:"username" validate:"required"`
+	Password string `mapstructure:"password" validate:"required"`
+}
+
+// HeaderRules are headers added to or removed from a request or response
+type HeaderRules struct {
+	// Add sets each header to its value, replacing any values it already had
+	Add    map[string]string `mapstructure:"add"`
+	Remove []string          `mapstructure:"remove"`
+}
+
+// Apply removes then adds headers to header
+func (r HeaderRules) Apply(header http.Header) {
+	for _, name := range r.Remove {
+		header.Del(name)
+	}
+	for name, value := range r.Add {
+		header.Set(name, value)
+	}
+}
+
+// HttpProxyOptions configures how an HttpProxy forwards requests
+type HttpProxyOptions struct {
+	// HostHeader is the Host sent upstream. When empty the Host of the public request is kept and when
+	// it's HostHeaderRewrite the host of the upstream is used.
+	HostHeader string
+	// BasicAuth is optional, requests without the credentials are answered with a 401 when it's set
+	BasicAuth *BasicAuth
+	// RequestHeaders are applied to each request before it's sent upstream
+	RequestHeaders HeaderRules
+	// ResponseHeaders are applied to each response before it's sent back to the server
+	ResponseHeaders HeaderRules
+	// Inspector is optional and captures every request and response when it's set
+	Inspector *Inspector
+}
+
+// HttpProxy reads HTTP requests from each stream and proxies them to an upstream HTTP server
+type HttpProxy struct {
+	slogger   *zap.SugaredLogger
+	upstream  *url.URL
+	options   HttpProxyOptions
+	transport http.RoundTripper
+}
+
+func NewHttpProxy(logger *zap.Logger, upstream *url.URL, options HttpProxyOptions) *HttpProxy {
+	return &HttpProxy{
+		slogger:   logger.Sugar(),
+		upstream:  upstream,
+		options:   options,
+		transport: http.DefaultTransport.(*http.Transport).Clone(),
+	}
+}
+
+// ServeStream proxies each request read from the stream until the server half-closes it
+func (self *HttpProxy) ServeStream(stream streaming_connection.Stream) {
+	defer stream.Close()
+
+	reader := bufio.NewReader(stream)
+	for {
+		req, err := http.ReadRequest(reader)
+		if err != nil {
+			if err != io.EOF {
+				self.slogger.Warnf("Error reading request from stream %d: %v", stream.ID(), err)
+			}
+			break
+		}
+		// Close applies to the stream rather than the connection to the upstream
+		closeStream := req.Close
+		req.Close = false
+
+		var capture *exchangeCapture
+		if self.options.Inspector != nil {
+			capture = self.options.Inspector.capture(self, req, 0)
+		}
+		resp := self.serveRequest(req)
+		if resp.StatusCode == http.StatusSwitchingProtocols {
+			// Anything the server sent after the request belongs to the new protocol
+			self.switchProtocols(streaming_connection.NewPeekedConn(stream, reader), resp, capture)
+			return
+		}
+		if capture != nil {
+			capture.response(resp)
+		}
+		err = resp.Write(stream)
+		resp.Body.Close()
+		if capture != nil {
+			capture.finish(err)
+		}
+		if err != nil {
+			self.slogger.Warnf("Error writing response to stream %d: %v", stream.ID(), err)
+			break
+		}
+		if closeStream || resp.Close {
+			break
+		}
+	}
+	stream.CloseWrite()
+}
+
+// switchProtocols relays the upstream agreeing to upgrade the request then splices the stream to the
+// upgraded connection to the upstream, i.e. for websockets
+func (self *HttpProxy) switchProtocols(stream net.Conn, resp *http.Response, capture *exchangeCapture) {
+	upstream, ok := resp.Body.(io.ReadWriteCloser)
+	if !ok {
+		self.slogger.Warnf("Upstream %s switched protocols without handing over the connection", self.upstream)
+		resp.Body.Close()
+		stream.Close()
+		return
+	}
+
+	if capture != nil {
+		capture.response(resp)
+	}
+	err := http_helper.WriteSwitchingProtocols(stream, resp.Header)
+	if capture != nil {
+		// The exchange is complete once the protocol has switched, what's sent after isn't HTTP
+		capture.finish(err)
+	}
+	if err != nil {
+		self.slogger.Warnf("Error writing upgrade response to stream: %v", err)
+		upstream.Close()
+		stream.Close()
+		return
+	}
+
+	self.slogger.Debugf("Switched protocol to %s with %s", resp.Header.Get("Upgrade"), self.upstream)
+	if err := streaming_connection.Splice(stream, upstream); err != nil {
+		self.slogger.Debugf("Upgraded connection to %s finished with: %v", self.upstream, err)
+	}
+}
+
+// serveRequest proxies req if it's authorized
+func (self *HttpProxy) serveRequest(req *http.Request) *http.Response {
+	if !self.authorized(req) {
+		// Closing the body reads the rest of it so the next request can be read from the stream
+		req.Body.Close()
+		resp := textResponse(http.StatusUnauthorized, "unauthorized\n")
+		resp.Header.Set("WWW-Authenticate", `Basic realm="yuka"`)
+		return resp
+	}
+	resp := self.roundTrip(req)
+	self.options.ResponseHeaders.Apply(resp.Header)
+	return resp
+}
+
+// roundTrip sends req to the upstream, a 502 is returned if the upstream can't be reached
+func (self *HttpProxy) roundTrip(req *http.Request) *http.Response {
+	req.RequestURI = ""
+	req.URL.Scheme = self.upstream.Scheme
+	req.URL.Host = self.upstream.Host
+	switch self.options.HostHeader {
+	case "":
+	case HostHeaderRewrite:
+		req.Host = self.upstream.Host
+	default:
+		req.Host = self.options.HostHeader
+	}
+	if self.options.BasicAuth != nil {
+		// The credentials are for the tunnel rather than the upstream
+		req.Header.Del("Authorization")
+	}
+	self.options.RequestHeaders.Apply(req.Header)
+
+	self.slogger.Debugf("Proxying %s %s", req.Method, req.URL)
+	resp, err := self.transport.RoundTrip(req)
+	if err != nil {
+		self.slogger.Warnf("Error proxying request to %s: %v", self.upstream, err)
+		return textResponse(http.StatusBadGateway, fmt.Sprintf("yuka agent failed to reach %s: %v\n", self.upstream, err))
+	}
+	return resp
+}
+
+// authorized checks req has the credentials needed when basic auth is enabled
+func (self *HttpProxy) authorized(req *http.Request) bool {
+	if self.options.BasicAuth == nil {
+		return true
+	}
+	username, password, ok := req.BasicAuth()
+	if !ok {
+		return false
+	}
+	usernameMatch := subtle.ConstantTimeCompare([]byte(username), []byte(self.options.BasicAuth.Username))
+	passwordMatch := subtle.ConstantTimeCompare([]byte(password), []byte(self.options.BasicAuth.Password))
+	return usernameMatch&passwordMatch == 1
+}
+
+func textResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode:    statusCode,
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"text/plain; charset=utf-8"}},
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+	}
+}
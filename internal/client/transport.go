@@ -0,0 +1,190 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"yuka/pkg/streaming_connection"
+	"yuka/pkg/tls_helper"
+
+	"github.com/gorilla/websocket"
+)
+
+// Transport is how the agent's connection to the server is carried
+type Transport string
+
+const (
+	// TransportTcp connects straight to the server's tunnel listener
+	TransportTcp Transport = "tcp"
+	// TransportQuic connects to the server's tunnel listener over QUIC, each request getting a stream of its
+	// own and the connection surviving the agent's address changing
+	TransportQuic Transport = "quic"
+	// TransportWs connects over a websocket to the api server, going through the proxy set by HTTPS_PROXY
+	// or HTTP_PROXY if there is one. It's carried over wss when TLS is configured.
+	TransportWs Transport = "ws"
+	// TransportAuto uses quic, falling back to tcp when UDP is blocked and to ws when the tunnel listener
+	// can't be reached at all. When TLS is configured every one of them is encrypted.
+	TransportAuto Transport = "auto"
+)
+
+// TLSOptions are the yukactl flags securing the connection to the tunnel listener
+type TLSOptions struct {
+	Enabled bool `flag:"tls"`
+	// ServerCA verifies the server's certificate instead of the system's CAs
+	ServerCA string `flag:"server-ca"`
+	// ServerFingerprint pins the SHA-256 fingerprint of the server's certificate or one of its CAs
+	ServerFingerprint string `flag:"server-fingerprint"`
+	// ClientCert and ClientKey authenticate the agent to servers requiring mutual TLS
+	ClientCert string `flag:"client-cert" validate:"required_with=ClientKey"`
+	ClientKey  string `flag:"client-key" validate:"required_with=ClientCert"`
+}
+
+// Config returns the config for connecting to the tunnel listener, nil when TLS isn't enabled. Any of the
+// other options enable TLS.
+func (o TLSOptions) Config() (*tls.Config, error) {
+	if !o.Enabled && o.ServerCA == "" && o.ServerFingerprint == "" && o.ClientCert == "" {
+		return nil, nil
+	}
+	return tls_helper.NewClientConfig(tls_helper.ClientOptions{
+		CAFile:      o.ServerCA,
+		Fingerprint: o.ServerFingerprint,
+		CertFile:    o.ClientCert,
+		KeyFile:     o.ClientKey,
+	})
+}
+
+// wsBufferSize fits a whole multiplexed frame so each is sent as a single websocket frame
+const wsBufferSize = 32 * 1024
+
+// ParseTransport validates s is a known transport. An empty string returns TransportTcp.
+func ParseTransport(s string) (Transport, error) {
+	switch transport := Transport(s); transport {
+	case "":
+		return TransportTcp, nil
+	case TransportTcp, TransportQuic, TransportWs, TransportAuto:
+		return transport, nil
+	default:
+		return "", fmt.Errorf("unknown transport %q, must be one of tcp, quic, ws or auto", s)
+	}
+}
+
+// webSocketURL returns the URL of the api server's websocket endpoint, wss is used when secure is set or
+// it's served on the standard https port
+func webSocketURL(apiServerAddress string, secure bool) string {
+	scheme := "ws"
+	if _, port, err := net.SplitHostPort(apiServerAddress); secure || (err == nil && port == "443") {
+		scheme = "wss"
+	}
+	return (&url.URL{Scheme: scheme, Host: apiServerAddress, Path: "/ws"}).String()
+}
+
+// dial connects to the server with the configured transport. With TransportAuto the transport that
+// connected last time is tried first so an agent behind a proxy doesn't wait on the others every reconnect.
+func (self *Tunnel) dial(ctx context.Context) (net.Conn, error) {
+	if self.config.Transport != TransportAuto {
+		return self.dialTransport(ctx, self.config.Transport)
+	}
+
+	transports := []Transport{TransportQuic, TransportTcp, TransportWs}
+	if self.autoTransport != "" {
+		transports = slices.DeleteFunc(transports, func(transport Transport) bool { return transport == self.autoTransport })
+		transports = append([]Transport{self.autoTransport}, transports...)
+	}
+	var errs []error
+	for _, transport := range transports {
+		conn, err := self.dialTransport(ctx, transport)
+		if err == nil {
+			if transport != self.autoTransport {
+				self.slogger.Infof("Connecting to server over %s", transport)
+			}
+			self.autoTransport = transport
+			return conn, nil
+		}
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		self.slogger.Debugf("Unable to connect to server over %s: %v", transport, err)
+		errs = append(errs, fmt.Errorf("%s: %v", transport, err))
+	}
+	return nil, fmt.Errorf("unable to connect over any transport: %v", errors.Join(errs...))
+}
+
+func (self *Tunnel) dialTransport(ctx context.Context, transport Transport) (net.Conn, error) {
+	switch transport {
+	case TransportQuic:
+		return self.dialQuic(ctx)
+	case TransportWs:
+		return self.dialWs(ctx)
+	default:
+		return self.dialTcp(ctx)
+	}
+}
+
+// newSession starts the session requests arrive on once the handshake has been made over conn
+func (self *Tunnel) newSession(conn net.Conn, hello *streaming_connection.Hello, settings streaming_connection.SessionSettings) agentSession {
+	if control, ok := conn.(*streaming_connection.QuicStream); ok {
+		// QUIC has streams of its own so there's nothing to multiplex
+		return streaming_connection.NewQuicStreamingConnection(self.slogger.Desugar(), control, hello, settings)
+	}
+	return streaming_connection.NewMuxSession(self.slogger.Desugar(), conn, true, settings.MuxConfig())
+}
+
+// dialQuic connects to the tunnel listener over QUIC, returning the control stream the handshake is made on
+func (self *Tunnel) dialQuic(ctx context.Context) (net.Conn, error) {
+	var tlsConfig *tls.Config
+	if self.config.TLSConfig != nil {
+		tlsConfig = self.config.TLSConfig.Clone()
+	} else {
+		// Without TLS settings there's nothing to verify the server's certificate against, the connection
+		// is trusted no more than a plaintext one over tcp
+		tlsConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	tlsConfig.NextProtos = []string{streaming_connection.QuicALPN}
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+	control, err := streaming_connection.DialQuic(ctx, self.config.ServerAddress, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	return control, nil
+}
+
+func (self *Tunnel) dialTcp(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: dialTimeout, KeepAlive: keepAlivePeriod}
+	if self.config.TLSConfig == nil {
+		return dialer.DialContext(ctx, "tcp", self.config.ServerAddress)
+	}
+	tlsDialer := tls.Dialer{NetDialer: dialer, Config: self.config.TLSConfig}
+	return tlsDialer.DialContext(ctx, "tcp", self.config.ServerAddress)
+}
+
+// dialWs connects to the api server's websocket endpoint. When TLS is configured it's verified the same way as
+// the tunnel listener and a ws URL is refused rather than falling back to plaintext.
+func (self *Tunnel) dialWs(ctx context.Context) (net.Conn, error) {
+	if self.config.TLSConfig != nil && !strings.HasPrefix(self.config.WebSocketURL, "wss://") {
+		return nil, fmt.Errorf("tls is enabled but the websocket url %s isn't wss", self.config.WebSocketURL)
+	}
+	netDialer := net.Dialer{Timeout: dialTimeout, KeepAlive: keepAlivePeriod}
+	dialer := websocket.Dialer{
+		NetDialContext:   netDialer.DialContext,
+		Proxy:            http.ProxyFromEnvironment,
+		TLSClientConfig:  self.config.TLSConfig,
+		HandshakeTimeout: dialTimeout,
+		ReadBufferSize:   wsBufferSize,
+		WriteBufferSize:  wsBufferSize,
+	}
+	ws, resp, err := dialer.DialContext(ctx, self.config.WebSocketURL, nil)
+	if err != nil {
+		if resp != nil {
+			return nil, fmt.Errorf("websocket handshake with %s failed with status %d: %v", self.config.WebSocketURL, resp.StatusCode, err)
+		}
+		return nil, err
+	}
+	return streaming_connection.NewWsConn(ws), nil
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"yuka/pkg/streaming_connection"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestWebSocketURL(t *testing.T) {
+	assert.Equal(t, "ws://localhost:8080/ws", webSocketURL("localhost:8080", false))
+	assert.Equal(t, "wss://yuka.dev:443/ws", webSocketURL("yuka.dev:443", false))
+	assert.Equal(t, "wss://localhost:8443/ws", webSocketURL("localhost:8443", true))
+}
+
+func TestDialWsUsesTLSConfig(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		conn := streaming_connection.NewWsConn(ws)
+		io.Copy(conn, conn)
+		conn.Close()
+	}))
+	t.Cleanup(server.Close)
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+	address := strings.TrimPrefix(server.URL, "https://")
+
+	tunnel := NewTunnel(zap.NewNop(), TunnelConfig{
+		WebSocketURL: webSocketURL(address, true),
+		TLSConfig:    &tls.Config{RootCAs: roots},
+	})
+	conn, err := tunnel.dialWs(context.Background())
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("hello"))
+	require.NoError(t, err)
+	resp := make([]byte, 5)
+	_, err = io.ReadFull(conn, resp)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(resp))
+
+	// Without the server's CA it can't be verified
+	tunnel = NewTunnel(zap.NewNop(), TunnelConfig{
+		WebSocketURL: webSocketURL(address, true),
+		TLSConfig:    &tls.Config{},
+	})
+	_, err = tunnel.dialWs(context.Background())
+	assert.Error(t, err)
+}
+
+func TestDialWsRefusesPlaintextWithTLS(t *testing.T) {
+	tunnel := NewTunnel(zap.NewNop(), TunnelConfig{
+		WebSocketURL: webSocketURL("localhost:8080", false),
+		TLSConfig:    &tls.Config{},
+	})
+	_, err := tunnel.dialWs(context.Background())
+	assert.ErrorContains(t, err, "isn't wss")
+}
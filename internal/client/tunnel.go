@@ -2,103 +2,329 @@ package client
 
 import (
 	"context"
-	"encoding/binary"
+	"crypto/tls"
+	"errors"
 	"fmt"
-	"io"
-	"net"
+	"slices"
+	"sync"
+	"time"
+	"yuka/internal/consts"
 	"yuka/pkg/streaming_connection"
 
+	"github.com/cenkalti/backoff/v4"
 	"go.uber.org/zap"
 )
 
+const (
+	// drainTimeout is how long in-flight requests have to finish once the tunnel starts shutting down
+	drainTimeout = 10 * time.Second
+	dialTimeout  = 10 * time.Second
+	// keepAlivePeriod is how often the OS probes the connection so a dead server is noticed while idle
+	keepAlivePeriod = 15 * time.Second
+
+	reconnectInitialInterval = 500 * time.Millisecond
+	reconnectMaxInterval     = 30 * time.Second
+)
+
+// TunnelState describes the connection between a Tunnel and the server
+type TunnelState int
+
+const (
+	// TunnelStateConnecting is the initial state while the first connection is being made
+	TunnelStateConnecting TunnelState = iota
+	// TunnelStateOnline means the tunnel is registered and serving requests
+	TunnelStateOnline
+	// TunnelStateReconnecting means the connection was lost and the tunnel is trying to register again
+	TunnelStateReconnecting
+	// TunnelStateOffline means the tunnel has stopped and won't reconnect
+	TunnelStateOffline
+)
+
+func (s TunnelState) String() string {
+	switch s {
+	case TunnelStateConnecting:
+		return "connecting"
+	case TunnelStateOnline:
+		return "online"
+	case TunnelStateReconnecting:
+		return "reconnecting"
+	case TunnelStateOffline:
+		return "offline"
+	default:
+		return "unknown"
+	}
+}
+
+// TunnelSpec is a tunnel to register with the server along with the handler that serves its streams
+type TunnelSpec struct {
+	streaming_connection.TunnelRequest
+	Handler StreamHandler
+}
+
+// TunnelConfig configures a Tunnel
+type TunnelConfig struct {
+	// ServerAddress is the address of the server's tunnel listener, i.e localhost:8085
+	ServerAddress string
+	// Transport is how the connection to the server is carried, tcp is used when it's empty
+	Transport Transport
+	// WebSocketURL is the api server's websocket endpoint used by TransportWs, i.e ws://localhost:8080/ws
+	WebSocketURL string
+	// TLSConfig secures the connection to the tunnel listener, which is plaintext when it's nil. QUIC is always
+	// encrypted but the server's certificate is only verified when it's set.
+	TLSConfig *tls.Config
+	// AuthToken is the API token the server authenticates the agent with
+	AuthToken string
+	// Tunnels are registered with the server on every connection, they're all served over the same connection
+	Tunnels []TunnelSpec
+	// OnRegistered is optional and called with the tunnels assigned by the server every time it registers
+	OnRegistered func(tunnels []streaming_connection.TunnelAssignment)
+}
+
+// agentSession is the connection to the server requests arrive on, a MuxSession over tcp or a websocket
+// and a QuicStreamingConnection over QUIC
+type agentSession interface {
+	streaming_connection.StreamingConnection
+	GoAway() error
+}
+
 type Tunnel struct {
-	slogger         zap.SugaredLogger
-	serverHostname  string
-	forwardHostname string
+	slogger zap.SugaredLogger
+	config  TunnelConfig
+
+	// tunnels are sent to the server on every connection. Once the server has assigned a hostname or port
+	// it's pinned here so the tunnel keeps the same public address across reconnects.
+	tunnels []streaming_connection.TunnelRequest
+
+	stateLock sync.Mutex
+	state     TunnelState
+	// session is the current connection to the server, nil while not online
+	session agentSession
+	// assignments are the tunnels assigned by the server the last time it registered
+	assignments []streaming_connection.TunnelAssignment
+	// handlers maps the hostname of each assigned tunnel to the handler serving it
+	handlers map[string]StreamHandler
+	// streamHeaders is set when the server starts each stream with a StreamHeader naming its tunnel
+	streamHeaders bool
+	// autoTransport is the transport that last connected when using TransportAuto
+	autoTransport Transport
 }
 
-func NewTunnel(logger *zap.Logger, serverHostname string, forwardHostname string) *Tunnel {
+func NewTunnel(logger *zap.Logger, config TunnelConfig) *Tunnel {
+	tunnels := make([]streaming_connection.TunnelRequest, len(config.Tunnels))
+	for i, spec := range config.Tunnels {
+		tunnels[i] = spec.TunnelRequest
+	}
 	return &Tunnel{
-		slogger:         *logger.Sugar(),
-		serverHostname:  serverHostname,
-		forwardHostname: forwardHostname,
+		slogger: *logger.Sugar(),
+		config:  config,
+		tunnels: tunnels,
 	}
+}
+
+// State returns the current state of the connection to the server
+func (self *Tunnel) State() TunnelState {
+	self.stateLock.Lock()
+	defer self.stateLock.Unlock()
+	return self.state
+}
+
+// Latency returns the round trip time to the server measured by the last heartbeat, zero while not online
+func (self *Tunnel) Latency() time.Duration {
+	self.stateLock.Lock()
+	defer self.stateLock.Unlock()
+	if self.session == nil {
+		return 0
+	}
+	return self.session.Latency()
+}
+
+// Assignments returns the tunnels assigned by the server the last time the tunnel registered
+func (self *Tunnel) Assignments() []streaming_connection.TunnelAssignment {
+	self.stateLock.Lock()
+	defer self.stateLock.Unlock()
+	return slices.Clone(self.assignments)
+}
+
+func (self *Tunnel) setSession(session agentSession) {
+	self.stateLock.Lock()
+	defer self.stateLock.Unlock()
+	self.session = session
+}
 
+func (self *Tunnel) setState(state TunnelState) {
+	self.stateLock.Lock()
+	defer self.stateLock.Unlock()
+	if self.state == state {
+		return
+	}
+	self.slogger.Infof("Tunnel is %s", state)
+	self.state = state
 }
 
-// Listen is a blocking call that starts up the TCP server
+// Connect is a blocking call that connects to the server and passes every stream it opens on to
+// the handler. If the connection is lost the tunnel reconnects with a jittered exponential
+// backoff, only returning if the server rejects the tunnel.
 //
 // Will close on ctx.Done() being called
 func (self *Tunnel) Connect(ctx context.Context) error {
-	// Setup connection to server
-	conn, err := net.Dial("tcp", self.serverHostname)
+	self.slogger.Infof("Tunnel is %s", self.State())
+
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = reconnectInitialInterval
+	b.MaxInterval = reconnectMaxInterval
+	// Keep retrying until the context is cancelled
+	b.MaxElapsedTime = 0
+
+	for {
+		session, err := backoff.RetryNotifyWithData(func() (agentSession, error) {
+			session, err := self.register(ctx)
+			var rejected *streaming_connection.HandshakeRejectedError
+			if errors.As(err, &rejected) {
+				return nil, backoff.Permanent(err)
+			}
+			return session, err
+		}, backoff.WithContext(b, ctx), func(err error, next time.Duration) {
+			self.slogger.Warnf("Error connecting to server, retrying in %v: %v", next.Round(time.Millisecond), err)
+		})
+		if err != nil {
+			self.setState(TunnelStateOffline)
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("error registering with server: %v", err)
+		}
+
+		self.setSession(session)
+		self.setState(TunnelStateOnline)
+		err = self.serve(ctx, session)
+		self.setSession(nil)
+		if ctx.Err() != nil {
+			self.setState(TunnelStateOffline)
+			return nil
+		}
+		self.slogger.Warnf("Lost connection to server: %v", err)
+		self.setState(TunnelStateReconnecting)
+	}
+}
+
+// register dials the server and registers the tunnels, returning the session requests arrive on
+func (self *Tunnel) register(ctx context.Context) (agentSession, error) {
+	conn, err := self.dial(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer conn.Close()
 
-	// Register client with metadata
-	metadata := streaming_connection.NewConnectionMetadata("seb-hostname")
-	b, err := metadata.Serialize()
+	hello := streaming_connection.NewHello(consts.Version, self.config.AuthToken, self.tunnels)
+	reply, err := streaming_connection.ClientHandshake(conn, hello, streaming_connection.DefaultHandshakeTimeout)
 	if err != nil {
-		return err
+		conn.Close()
+		return nil, err
+	}
+	for i, tunnel := range reply.Tunnels {
+		self.slogger.Infof("Tunnel %s is available at %s", tunnel.Hostname, tunnel.PublicURL)
+		if i < len(self.tunnels) && tunnel.RemotePort != 0 {
+			self.tunnels[i].RemotePort = tunnel.RemotePort
+		} else if i < len(self.tunnels) {
+			self.tunnels[i].Hostname = tunnel.Hostname
+		}
+	}
+	handlers := make(map[string]StreamHandler, len(reply.Tunnels))
+	for i, tunnel := range reply.Tunnels {
+		if i < len(self.config.Tunnels) {
+			handlers[tunnel.Hostname] = self.config.Tunnels[i].Handler
+		}
 	}
-	// Send the size of the metadata first
-	metadataSize := int32(len(b))
-	if err := binary.Write(conn, binary.BigEndian, metadataSize); err != nil {
-		return fmt.Errorf("error writing metadata size: %v", err)
+	streamHeaders := reply.HasCapability(streaming_connection.CapabilityStreamHeaders)
+	if !streamHeaders && len(self.config.Tunnels) > 1 {
+		self.slogger.Warnf("Server can't tell tunnels apart, every request will be sent to tunnel %s", reply.Tunnels[0].Hostname)
 	}
 
-	// Send the actual metadata
-	if _, err := conn.Write(b); err != nil {
-		return fmt.Errorf("error writing metadata: %v", err)
+	self.stateLock.Lock()
+	self.assignments = reply.Tunnels
+	self.handlers = handlers
+	self.streamHeaders = streamHeaders
+	self.stateLock.Unlock()
+	if self.config.OnRegistered != nil {
+		self.config.OnRegistered(reply.Tunnels)
 	}
-	// conn.Write(b)
 
-	// self.slogger.Infof("Tunnel is listening on port %v", self.listenPort)
+	return self.newSession(conn, hello, reply.Settings), nil
+}
 
-	// Channel to signal new connections
-	// connChan := make(chan net.Conn)
-	errChan := make(chan error)
-	go self.forwardConnection(conn)
-	for {
-		select {
-		case <-ctx.Done():
-			// The context has been canceled, stop accepting new connections
-			self.slogger.Info("Shutting down connection...")
-			return nil
+// serve forwards the streams opened by the server until the session fails or ctx is cancelled
+func (self *Tunnel) serve(ctx context.Context, session agentSession) error {
+	defer session.Close()
 
-		case err := <-errChan:
-			// Handle accept error (usually indicates the server should shut down)
-			self.slogger.Warnf("Error accepting connection: %v", err)
+	// Every request the server receives for us arrives as a new stream on the session
+	errChan := make(chan error, 1)
+	go func() {
+		for {
+			stream, err := session.AcceptStream()
+			if err != nil {
+				errChan <- err
+				return
+			}
+			go self.serveStream(stream)
 		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		// The context has been canceled, stop accepting new streams
+		self.slogger.Info("Shutting down connection...")
+		self.drain(session)
+		return nil
+
+	case err := <-errChan:
+		return err
 	}
 }
 
-func (self *Tunnel) forwardConnection(conn net.Conn) error {
-	forwardConn, err := net.Dial("tcp", self.forwardHostname)
+// serveStream passes stream on to the handler of the tunnel it was opened for
+func (self *Tunnel) serveStream(stream streaming_connection.Stream) {
+	self.stateLock.Lock()
+	handlers, streamHeaders := self.handlers, self.streamHeaders
+	self.stateLock.Unlock()
+
+	if !streamHeaders {
+		// Older servers don't say which tunnel a stream is for so everything goes to the first one
+		self.config.Tunnels[0].Handler.ServeStream(stream)
+		return
+	}
+
+	header, err := streaming_connection.ReadStreamHeader(stream, streaming_connection.DefaultHandshakeTimeout)
 	if err != nil {
-		self.slogger.Errorf("Error occurred when dialing connection: %v", err)
-		conn.Close()
-		return err
+		self.slogger.Warnf("Error reading header of stream %d: %v", stream.ID(), err)
+		stream.Close()
+		return
+	}
+	handler, ok := handlers[header.Hostname]
+	if !ok {
+		self.slogger.Warnf("Received stream %d for unknown tunnel %s", stream.ID(), header.Hostname)
+		stream.Close()
+		return
 	}
+	handler.ServeStream(stream)
+}
 
-	go func() {
-		self.slogger.Info("Forwarding data from forwardConn to conn.")
-		if _, err := io.Copy(conn, forwardConn); err != nil {
-			self.slogger.Errorf("Error copying from forwardConn to conn: %v", err)
-		}
-		self.slogger.Info("Finished copying data from forwardConn to conn")
-		conn.Close() // Close after copying
-	}()
-	go func() {
-		self.slogger.Info("Forwarding data from conn to forwardConn.")
-		if _, err := io.Copy(forwardConn, conn); err != nil {
-			self.slogger.Errorf("Error copying from conn to forwardConn: %v", err)
-		}
-		self.slogger.Info("Finished copying data from conn to forwardConn")
-		forwardConn.Close() // Close after copying
-	}()
+// drain tells the server to stop sending new requests and waits for in-flight requests to finish
+func (self *Tunnel) drain(session agentSession) {
+	if err := session.GoAway(); err != nil {
+		self.slogger.Warnf("Error announcing shutdown to server: %v", err)
+		return
+	}
 
-	return nil
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	timeout := time.After(drainTimeout)
+	for session.NumStreams() > 0 {
+		select {
+		case <-ticker.C:
+		case <-timeout:
+			self.slogger.Warnf("Timed out waiting for %d in-flight requests to finish", session.NumStreams())
+			return
+		case <-session.CloseChan():
+			return
+		}
+	}
 }
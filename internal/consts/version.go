@@ -0,0 +1,4 @@
+package consts
+
+// Version of yuka, this is overridden at build time with -ldflags "-X yuka/internal/consts.Version=<version>"
+var Version = "dev"
@@ -11,6 +11,7 @@ import (
 	"gorm.io/driver/sqlite"
 	_ "gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
 )
 
 func ConnectDatabase(parent context.Context,
@@ -33,6 +34,9 @@ func ConnectDatabase(parent context.Context,
 		if err != nil {
 			return err
 		}
+		if err = setupJoinTables(db); err != nil {
+			return err
+		}
 		if err = db.AutoMigrate(
 			&models.Device{},
 			&models.Organization{},
@@ -40,6 +44,12 @@ func ConnectDatabase(parent context.Context,
 			&models.RegisteredApplication{},
 			&models.DeviceDNSQuery{},
 			&models.Invitation{},
+			&models.ApiToken{},
+			&models.Certificate{},
+			&models.AcmeAccount{},
+			&models.AcmeChallenge{},
+			&models.Domain{},
+			&models.TunnelSession{},
 		); err != nil {
 			return err
 		}
@@ -53,9 +63,10 @@ func ConnectDatabase(parent context.Context,
 	return db, nil
 }
 
-// ConnectDatabase creates a connection to the database, performs migration and returns the connection instance
+// ConnectTestDatabase creates a private in-memory database, performs migration and returns the connection
+// instance. Every call returns an empty database.
 func ConnectTestDatabase(logger *zap.Logger) (*gorm.DB, error) {
-	database, err := gorm.Open(sqlite.Open("test.db"), &gorm.Config{
+	database, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{
 		// This is set to translate errors to a common error across databases engines (i.e Sqlite, Postgres)
 		TranslateError: true,
 	})
@@ -63,20 +74,68 @@ func ConnectTestDatabase(logger *zap.Logger) (*gorm.DB, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Each connection to an in-memory database opens a database of its own, a single connection keeps them
+	// all on the same one and serializes concurrent transactions
+	sqlDB, err := database.DB()
+	if err != nil {
+		return nil, err
+	}
+	sqlDB.SetMaxOpenConns(1)
 
-	// TODO: Will need to move to proper migrations later on
-	if err = database.AutoMigrate(
+	if err = setupJoinTables(database); err != nil {
+		return nil, err
+	}
+	testModels := []interface{}{
 		// &models.Device{},
-		// &models.Organization{},
+		&models.Organization{},
 		&models.User{},
+		&models.UserOrganization{},
+		&models.ApiToken{},
+		&models.Certificate{},
+		&models.AcmeAccount{},
+		&models.AcmeChallenge{},
+		&models.Invitation{},
+		&models.Domain{},
+		&models.TunnelSession{},
 		// &models.RegisteredApplication{},
 		// &models.DeviceDNSQuery{},
-		// &models.Invitation{},
-	); err != nil {
-		println(err.Error())
+	}
+	if err = useSqliteColumns(database, testModels...); err != nil {
+		return nil, err
+	}
+	// TODO: Will need to move to proper migrations later on
+	if err = database.AutoMigrate(testModels...); err != nil {
 		return nil, err
 	}
 
 	logger.Info("Initialized database connection")
 	return database, nil
 }
+
+// setupJoinTables uses our own models for join tables that have columns of their own, it must be called
+// before migrating the models that use them
+func setupJoinTables(db *gorm.DB) error {
+	return db.SetupJoinTable(&models.User{}, "Organizations", &models.UserOrganization{})
+}
+
+// useSqliteColumns swaps the Postgres column types and defaults of the models for their Sqlite equivalents. Sqlite
+// can't parse a now() default and only scans times from datetime columns. Only the schemas cached by db are
+// changed, so the models keep their Postgres columns everywhere else.
+func useSqliteColumns(db *gorm.DB, models ...interface{}) error {
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return err
+		}
+		for _, field := range stmt.Schema.Fields {
+			if field.DataType == "timestamptz" {
+				field.DataType = schema.Time
+				delete(field.TagSettings, "TYPE")
+			}
+			if field.DefaultValue == "now()" {
+				field.DefaultValue = "CURRENT_TIMESTAMP"
+			}
+		}
+	}
+	return nil
+}
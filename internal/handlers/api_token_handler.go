@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+	"yuka/internal/models"
+	"yuka/pkg/streaming_connection"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	apiTokenPrefix = "yuka_"
+	// apiTokenDisplayLength is the number of characters of a token kept in ApiToken.Prefix
+	apiTokenDisplayLength = 9
+)
+
+type CreateApiTokenInput struct {
+	Name string `json:"name" binding:"required"`
+	// ExpiresAt is optional, tokens without it never expire
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// CreateApiTokenResponse is the only time the token itself is returned
+type CreateApiTokenResponse struct {
+	models.ApiToken
+	Token string `json:"token" example:"yuka_Xk3bQ2..."`
+}
+
+type ApiTokenHandler struct {
+	Db     *gorm.DB
+	Logger *zap.Logger
+}
+
+func NewApiTokenHandler(logger *zap.Logger, db *gorm.DB) ApiTokenHandler {
+	return ApiTokenHandler{
+		Db:     db,
+		Logger: logger,
+	}
+}
+
+// CreateApiToken issues a new token for the user, the token is only returned by this call
+func (c *ApiTokenHandler) CreateApiToken(userId string, input CreateApiTokenInput) (*CreateApiTokenResponse, error) {
+	var user models.User
+	if err := c.Db.Where("id = ?", userId).First(&user).Error; err != nil {
+		return nil, err
+	}
+
+	apiToken, token, err := newApiToken(user.ID, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Db.Create(&apiToken).Error; err != nil {
+		return nil, err
+	}
+
+	c.Logger.Info("Created api token", zap.Object("apiToken", &apiToken))
+	return &CreateApiTokenResponse{
+		ApiToken: apiToken,
+		Token:    token,
+	}, nil
+}
+
+// FindApiTokens returns every token belonging to the user
+func (c *ApiTokenHandler) FindApiTokens(userId string) ([]models.ApiToken, error) {
+	var apiTokens []models.ApiToken
+	if err := c.Db.Where("user_id = ?", userId).Find(&apiTokens).Error; err != nil {
+		return nil, err
+	}
+	return apiTokens, nil
+}
+
+// DeleteApiToken revokes a token, agents already connected with it stay connected
+func (c *ApiTokenHandler) DeleteApiToken(userId string, id string) error {
+	var apiToken models.ApiToken
+	if err := c.Db.Where("id = ? AND user_id = ?", id, userId).First(&apiToken).Error; err != nil {
+		return err
+	}
+
+	if err := c.Db.Delete(&apiToken).Error; err != nil {
+		return err
+	}
+
+	c.Logger.Info("Deleted api token", zap.Object("apiToken", &apiToken))
+	return nil
+}
+
+// Authenticate implements streaming_connection.Authenticator for agents connecting with a token
+func (c *ApiTokenHandler) Authenticate(token string) (*streaming_connection.AgentIdentity, error) {
+	if token == "" {
+		return nil, streaming_connection.ErrInvalidAuthToken
+	}
+
+	var apiToken models.ApiToken
+	if err := c.Db.Where("token_hash = ?", hashToken(token)).First(&apiToken).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, streaming_connection.ErrInvalidAuthToken
+		}
+		return nil, err
+	}
+	if apiToken.IsExpired() {
+		return nil, streaming_connection.ErrInvalidAuthToken
+	}
+
+	var user models.User
+	if err := c.Db.Where("id = ?", apiToken.UserId).First(&user).Error; err != nil {
+		return nil, err
+	}
+
+	if err := c.Db.Model(&apiToken).Update("last_used_at", time.Now()).Error; err != nil {
+		c.Logger.Sugar().Warnf("Error updating last used time of api token %s: %v", apiToken.ID, err)
+	}
+
+	return &streaming_connection.AgentIdentity{
+		UserID:         user.ID.String(),
+		OrganizationID: user.CurrentOrganizationId,
+	}, nil
+}
+
+// newApiToken returns a new token for the user along with the ApiToken storing its hash
+func newApiToken(userId uuid.UUID, input CreateApiTokenInput) (models.ApiToken, string, error) {
+	token, err := generateApiToken()
+	if err != nil {
+		return models.ApiToken{}, "", err
+	}
+	return models.ApiToken{
+		UserId:    userId,
+		Name:      input.Name,
+		Prefix:    token[:apiTokenDisplayLength],
+		TokenHash: hashToken(token),
+		ExpiresAt: input.ExpiresAt,
+	}, token, nil
+}
+
+// generateApiToken returns a random token with the yuka prefix so it's easy to spot if it's leaked
+func generateApiToken() (string, error) {
+	return generateToken(apiTokenPrefix)
+}
+
+// generateToken returns 32 random bytes encoded after prefix
+func generateToken(prefix string) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return prefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+	"yuka/internal/models"
+	"yuka/pkg/tls_helper"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CertificateHandler implements tls_helper.CertificateStore, keeping what's needed to obtain and serve ACME
+// certificates in the database so it's shared by every server instance
+type CertificateHandler struct {
+	Db     *gorm.DB
+	Logger *zap.Logger
+}
+
+func NewCertificateHandler(logger *zap.Logger, db *gorm.DB) CertificateHandler {
+	return CertificateHandler{
+		Db:     db,
+		Logger: logger,
+	}
+}
+
+func (c *CertificateHandler) GetCertificate(ctx context.Context, name string) (*tls_helper.StoredCertificate, error) {
+	var certificate models.Certificate
+	if err := c.Db.WithContext(ctx).Where("name = ?", name).First(&certificate).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, tls_helper.ErrNotInStore
+		}
+		return nil, err
+	}
+	return &tls_helper.StoredCertificate{
+		Name:           certificate.Name,
+		CertificatePEM: []byte(certificate.CertificatePEM),
+		PrivateKeyPEM:  []byte(certificate.PrivateKeyPEM),
+		ExpiresAt:      certificate.ExpiresAt,
+	}, nil
+}
+
+// PutCertificate stores a certificate, replacing the one it renews
+func (c *CertificateHandler) PutCertificate(ctx context.Context, stored *tls_helper.StoredCertificate) error {
+	certificate := models.Certificate{
+		Name:           stored.Name,
+		CertificatePEM: string(stored.CertificatePEM),
+		PrivateKeyPEM:  string(stored.PrivateKeyPEM),
+		ExpiresAt:      stored.ExpiresAt,
+	}
+	if err := c.Db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"certificate_pem", "private_key_pem", "expires_at", "updated_at"}),
+	}).Create(&certificate).Error; err != nil {
+		return err
+	}
+
+	c.Logger.Info("Stored certificate", zap.Object("certificate", &certificate))
+	return nil
+}
+
+func (c *CertificateHandler) GetAccountKey(ctx context.Context, directoryURL string) ([]byte, error) {
+	var account models.AcmeAccount
+	if err := c.Db.WithContext(ctx).Where("directory_url = ?", directoryURL).First(&account).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, tls_helper.ErrNotInStore
+		}
+		return nil, err
+	}
+	return []byte(account.PrivateKeyPEM), nil
+}
+
+func (c *CertificateHandler) PutAccountKey(ctx context.Context, directoryURL string, keyPEM []byte) error {
+	account := models.AcmeAccount{
+		DirectoryURL:  directoryURL,
+		PrivateKeyPEM: string(keyPEM),
+	}
+	return c.Db.WithContext(ctx).Create(&account).Error
+}
+
+func (c *CertificateHandler) GetChallenge(ctx context.Context, token string) (string, error) {
+	var challenge models.AcmeChallenge
+	if err := c.Db.WithContext(ctx).Where("token = ?", token).First(&challenge).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return "", tls_helper.ErrNotInStore
+		}
+		return "", err
+	}
+	return challenge.KeyAuthorization, nil
+}
+
+func (c *CertificateHandler) PutChallenge(ctx context.Context, token string, keyAuthorization string) error {
+	challenge := models.AcmeChallenge{
+		Token:            token,
+		KeyAuthorization: keyAuthorization,
+	}
+	return c.Db.WithContext(ctx).Create(&challenge).Error
+}
+
+func (c *CertificateHandler) DeleteChallenge(ctx context.Context, token string) error {
+	return c.Db.WithContext(ctx).Where("token = ?", token).Delete(&models.AcmeChallenge{}).Error
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"slices"
+	"yuka/internal/models"
+	"yuka/pkg/streaming_connection"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ConnectionInfo describes an agent connection registered for a hostname
+type ConnectionInfo struct {
+	Hostname   string `json:"hostname" example:"foo"`
+	RemoteAddr string `json:"remote_addr" example:"203.0.113.7:53412"`
+	// LatencyMs is the round trip time measured by the last heartbeat, zero until one has been answered
+	LatencyMs  float64 `json:"latency_ms" example:"12.5"`
+	NumStreams int     `json:"num_streams"`
+	Draining   bool    `json:"draining"`
+}
+
+type ConnectionHandler struct {
+	db             *gorm.DB
+	slogger        *zap.SugaredLogger
+	connectionPool *streaming_connection.StreamingConnectionPool
+}
+
+func NewConnectionHandler(logger *zap.Logger, db *gorm.DB, connectionPool *streaming_connection.StreamingConnectionPool) ConnectionHandler {
+	return ConnectionHandler{
+		db:             db,
+		slogger:        logger.Sugar(),
+		connectionPool: connectionPool,
+	}
+}
+
+// FindConnections returns the connections of the user's agents along with those of the agents acting for
+// the organizations the user is a member of, ordered by hostname
+func (c *ConnectionHandler) FindConnections(userId string) ([]ConnectionInfo, error) {
+	owners, err := c.ownersOf(userId)
+	if err != nil {
+		return nil, err
+	}
+	hostnames := c.connectionPool.Hostnames()
+	slices.Sort(hostnames)
+
+	connections := []ConnectionInfo{}
+	for _, hostname := range hostnames {
+		if !slices.Contains(owners, c.connectionPool.Owner(hostname)) {
+			continue
+		}
+		for _, conn := range c.connectionPool.GetConnections(hostname) {
+			connections = append(connections, ConnectionInfo{
+				Hostname:   hostname,
+				RemoteAddr: conn.RemoteAddr().String(),
+				LatencyMs:  float64(conn.Latency().Microseconds()) / 1000,
+				NumStreams: conn.NumStreams(),
+				Draining:   conn.IsDraining(),
+			})
+		}
+	}
+	return connections, nil
+}
+
+// ownersOf returns the owners hostnames are claimed under by the agents the user can see, see AgentIdentity.Owner
+func (c *ConnectionHandler) ownersOf(userId string) ([]string, error) {
+	var organizationIds []string
+	if err := c.db.Model(&models.UserOrganization{}).Where("user_id = ?", userId).Pluck("organization_id", &organizationIds).Error; err != nil {
+		return nil, err
+	}
+	identity := streaming_connection.AgentIdentity{UserID: userId}
+	owners := []string{identity.Owner()}
+	for _, organizationId := range organizationIds {
+		owners = append(owners, streaming_connection.OrganizationOwner(organizationId))
+	}
+	return owners, nil
+}
@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"time"
+	"yuka/internal/models"
+	"yuka/pkg/streaming_connection"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	// DomainVerificationPrefix is prepended to a custom domain to get the name of the TXT record its
+	// verification token is published in, i.e _yuka-challenge.app.acme.com
+	DomainVerificationPrefix      = "_yuka-challenge."
+	domainVerificationTokenPrefix = "yuka-verification="
+	// domainVerificationTimeout is how long looking up the TXT records of a domain can take
+	domainVerificationTimeout = 10 * time.Second
+)
+
+var (
+	ErrDomainReserved    = errors.New("domain is already reserved")
+	ErrDomainInvalid     = errors.New("domain must be a subdomain of the public host or a custom domain")
+	ErrDomainNotVerified = errors.New("verification token was not found in the domain's TXT record")
+)
+
+type ReserveDomainInput struct {
+	OrganizationId string `json:"organization_id" binding:"required,uuid"`
+	// Name is a subdomain of the public host, i.e "acme", or a custom domain, i.e "app.acme.com"
+	Name string `json:"name" binding:"required,hostname_rfc1123" example:"acme"`
+}
+
+type DomainHandler struct {
+	Db     *gorm.DB
+	Logger *zap.Logger
+	// PublicHost is the host the tunnel router is publicly reachable on, reserved names are stored in the
+	// canonical form the tunnel listener looks them up by, see streaming_connection.CanonicalHostname
+	PublicHost string
+	// LookupTXT returns the TXT records of a name, custom domains are verified with it
+	LookupTXT func(ctx context.Context, name string) ([]string, error)
+}
+
+func NewDomainHandler(logger *zap.Logger, db *gorm.DB, publicHost string) DomainHandler {
+	return DomainHandler{
+		Db:         db,
+		Logger:     logger,
+		PublicHost: publicHost,
+		LookupTXT:  net.DefaultResolver.LookupTXT,
+	}
+}
+
+// ReserveDomain reserves a domain for an organization, the user must be one of its owners or admins. Subdomains
+// of the public host are reserved straight away, custom domains are pending until they're verified with
+// VerifyDomain. Agents already serving the domain for someone else keep it until they go away.
+func (c *DomainHandler) ReserveDomain(userId string, input ReserveDomainInput) (*models.Domain, error) {
+	createdBy, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, err
+	}
+	name, err := streaming_connection.CanonicalHostname(input.Name, c.PublicHost)
+	if err != nil {
+		return nil, ErrDomainInvalid
+	}
+
+	var domain models.Domain
+	err = c.Db.Transaction(func(tx *gorm.DB) error {
+		organization, _, err := authorizeMember(tx, userId, input.OrganizationId, models.OrganizationRoleAdmin)
+		if err != nil {
+			return err
+		}
+		// Pending reservations of other organizations don't count, they haven't proven the domain is theirs
+		if err := tx.Where("name = ? AND (verified_at IS NOT NULL OR organization_id = ?)", name, organization.ID).First(&models.Domain{}).Error; err == nil {
+			return ErrDomainReserved
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		domain = models.Domain{
+			OrganizationId: organization.ID,
+			Name:           name,
+			CreatedBy:      createdBy,
+		}
+		if streaming_connection.IsCustomDomain(name) {
+			if domain.VerificationToken, err = generateToken(domainVerificationTokenPrefix); err != nil {
+				return err
+			}
+		} else {
+			now := time.Now()
+			domain.VerifiedAt = &now
+		}
+		if err := tx.Create(&domain).Error; err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return ErrDomainReserved
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Info("Reserved domain", zap.Object("domain", &domain))
+	return &domain, nil
+}
+
+// VerifyDomain verifies the organization owns a pending custom domain by looking for its verification token in
+// the TXT record named after DomainVerificationPrefix, the user must be one of the owners or admins of the
+// organization. The pending reservations other organizations have of the domain are deleted once it's
+// verified. Returns gorm.ErrRecordNotFound if there's no such domain.
+func (c *DomainHandler) VerifyDomain(userId string, id string) (*models.Domain, error) {
+	var domain models.Domain
+	if err := c.Db.Where("id = ?", id).First(&domain).Error; err != nil {
+		return nil, err
+	}
+	if _, _, err := authorizeMember(c.Db, userId, domain.OrganizationId.String(), models.OrganizationRoleAdmin); err != nil {
+		return nil, err
+	}
+	if domain.IsVerified() {
+		return &domain, nil
+	}
+
+	// The lookup is made outside of the transaction so a slow resolver doesn't hold up the database
+	ctx, cancel := context.WithTimeout(context.Background(), domainVerificationTimeout)
+	defer cancel()
+	records, err := c.LookupTXT(ctx, DomainVerificationPrefix+domain.Name)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return nil, ErrDomainNotVerified
+		}
+		return nil, fmt.Errorf("unable to look up TXT record of domain %s: %v", domain.Name, err)
+	}
+	if !slices.Contains(records, domain.VerificationToken) {
+		return nil, ErrDomainNotVerified
+	}
+
+	err = c.Db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(&models.Domain{}).Where("id = ?", domain.ID).Update("verified_at", &now)
+		if result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+				return ErrDomainReserved
+			}
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		domain.VerifiedAt = &now
+		return tx.Where("name = ? AND id <> ?", domain.Name, domain.ID).Delete(&models.Domain{}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Info("Verified domain", zap.Object("domain", &domain))
+	return &domain, nil
+}
+
+// FindDomains returns the domains reserved for every organization the user is a member of
+func (c *DomainHandler) FindDomains(userId string) ([]models.Domain, error) {
+	var domains []models.Domain
+	if err := c.Db.Joins("JOIN user_organizations ON user_organizations.organization_id = domains.organization_id").
+		Where("user_organizations.user_id = ?", userId).
+		Order("domains.name").
+		Find(&domains).Error; err != nil {
+		return nil, err
+	}
+	return domains, nil
+}
+
+// ReleaseDomain deletes the reservation of a domain, the user must be one of the owners or admins of its
+// organization. Returns gorm.ErrRecordNotFound if there's no such domain.
+func (c *DomainHandler) ReleaseDomain(userId string, id string) error {
+	return c.Db.Transaction(func(tx *gorm.DB) error {
+		var domain models.Domain
+		if err := tx.Where("id = ?", id).First(&domain).Error; err != nil {
+			return err
+		}
+		if _, _, err := authorizeMember(tx, userId, domain.OrganizationId.String(), models.OrganizationRoleAdmin); err != nil {
+			return err
+		}
+		if err := tx.Delete(&domain).Error; err != nil {
+			return err
+		}
+
+		c.Logger.Info("Released domain", zap.Object("domain", &domain))
+		return nil
+	})
+}
+
+// ReservedFor implements streaming_connection.Reservations for the tunnel listener, pending reservations
+// are ignored
+func (c *DomainHandler) ReservedFor(hostname string) (string, error) {
+	name, err := streaming_connection.CanonicalHostname(hostname, c.PublicHost)
+	if err != nil {
+		return "", nil
+	}
+	var domain models.Domain
+	if err := c.Db.Where("name = ? AND verified_at IS NOT NULL", name).First(&domain).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return streaming_connection.OrganizationOwner(domain.OrganizationId.String()), nil
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"net"
+	"testing"
+	"yuka/internal/models"
+	"yuka/pkg/streaming_connection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func TestDomainReservationsConflictAcrossOrganizations(t *testing.T) {
+	db := newTestDatabase(t)
+	handler := NewDomainHandler(zap.NewNop(), db, "yuka.dev")
+	alice := createTestUser(t, db, "alice")
+	bob := createTestUser(t, db, "bob")
+	aliceOrganization, _ := createTestOrganization(t, db, alice)
+	bobOrganization, bobMembers := createTestOrganization(t, db, bob, models.OrganizationRoleMember)
+
+	domain, err := handler.ReserveDomain(alice, ReserveDomainInput{OrganizationId: aliceOrganization, Name: "acme"})
+	require.NoError(t, err)
+	assert.Equal(t, "acme", domain.Name)
+	// Every spelling of a hostname is the same reservation
+	for _, name := range []string{"acme", "ACME", "acme.yuka.dev", "Acme.Yuka.Dev."} {
+		_, err = handler.ReserveDomain(bob, ReserveDomainInput{OrganizationId: bobOrganization, Name: name})
+		assert.ErrorIs(t, err, ErrDomainReserved, name)
+	}
+	_, err = handler.ReserveDomain(bob, ReserveDomainInput{OrganizationId: bobOrganization, Name: "Example.com"})
+	require.NoError(t, err)
+
+	for _, name := range []string{"yuka.dev", "a.b.yuka.dev", "-acme"} {
+		_, err = handler.ReserveDomain(alice, ReserveDomainInput{OrganizationId: aliceOrganization, Name: name})
+		assert.ErrorIs(t, err, ErrDomainInvalid, name)
+	}
+	_, err = handler.ReserveDomain(bobMembers[0], ReserveDomainInput{OrganizationId: bobOrganization, Name: "bob"})
+	assert.ErrorIs(t, err, ErrOrganizationRoleRequired)
+	_, err = handler.ReserveDomain(bob, ReserveDomainInput{OrganizationId: aliceOrganization, Name: "bob"})
+	assert.ErrorIs(t, err, ErrNotOrganizationMember)
+
+	owner, err := handler.ReservedFor("ACME.yuka.dev:8081")
+	require.NoError(t, err)
+	assert.Equal(t, streaming_connection.OrganizationOwner(aliceOrganization), owner)
+	// Custom domains are pending until they're verified
+	owner, err = handler.ReservedFor("example.com")
+	require.NoError(t, err)
+	assert.Empty(t, owner)
+	owner, err = handler.ReservedFor("free.yuka.dev")
+	require.NoError(t, err)
+	assert.Empty(t, owner)
+
+	domains, err := handler.FindDomains(bobMembers[0])
+	require.NoError(t, err)
+	require.Len(t, domains, 1)
+	assert.Equal(t, "example.com", domains[0].Name)
+
+	// Only the organization holding a reservation can release it, after which anyone can take it
+	assert.ErrorIs(t, handler.ReleaseDomain(bob, domain.ID.String()), ErrNotOrganizationMember)
+	require.NoError(t, handler.ReleaseDomain(alice, domain.ID.String()))
+	_, err = handler.ReserveDomain(bob, ReserveDomainInput{OrganizationId: bobOrganization, Name: "acme.yuka.dev"})
+	assert.NoError(t, err)
+}
+
+func TestCustomDomainsAreVerifiedWithTxtRecords(t *testing.T) {
+	db := newTestDatabase(t)
+	handler := NewDomainHandler(zap.NewNop(), db, "yuka.dev")
+	records := map[string][]string{}
+	handler.LookupTXT = func(ctx context.Context, name string) ([]string, error) {
+		if values, ok := records[name]; ok {
+			return values, nil
+		}
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	alice := createTestUser(t, db, "alice")
+	bob := createTestUser(t, db, "bob")
+	aliceOrganization, aliceMembers := createTestOrganization(t, db, alice, models.OrganizationRoleMember)
+	bobOrganization, _ := createTestOrganization(t, db, bob)
+
+	// Anyone can have a pending reservation of a custom domain, it doesn't stop the owner from reserving it
+	squatted, err := handler.ReserveDomain(bob, ReserveDomainInput{OrganizationId: bobOrganization, Name: "app.acme.com"})
+	require.NoError(t, err)
+	assert.False(t, squatted.IsVerified())
+	domain, err := handler.ReserveDomain(alice, ReserveDomainInput{OrganizationId: aliceOrganization, Name: "app.acme.com"})
+	require.NoError(t, err)
+	assert.NotEqual(t, squatted.VerificationToken, domain.VerificationToken)
+	_, err = handler.ReserveDomain(alice, ReserveDomainInput{OrganizationId: aliceOrganization, Name: "app.acme.com"})
+	assert.ErrorIs(t, err, ErrDomainReserved)
+
+	_, err = handler.VerifyDomain(alice, domain.ID.String())
+	assert.ErrorIs(t, err, ErrDomainNotVerified)
+	records[DomainVerificationPrefix+"app.acme.com"] = []string{"v=spf1 -all", squatted.VerificationToken}
+	_, err = handler.VerifyDomain(alice, domain.ID.String())
+	assert.ErrorIs(t, err, ErrDomainNotVerified)
+	owner, err := handler.ReservedFor("app.acme.com")
+	require.NoError(t, err)
+	assert.Empty(t, owner)
+
+	records[DomainVerificationPrefix+"app.acme.com"] = []string{domain.VerificationToken}
+	_, err = handler.VerifyDomain(aliceMembers[0], domain.ID.String())
+	assert.ErrorIs(t, err, ErrOrganizationRoleRequired)
+	verified, err := handler.VerifyDomain(alice, domain.ID.String())
+	require.NoError(t, err)
+	assert.True(t, verified.IsVerified())
+	owner, err = handler.ReservedFor("app.acme.com")
+	require.NoError(t, err)
+	assert.Equal(t, streaming_connection.OrganizationOwner(aliceOrganization), owner)
+
+	// The pending reservations of other organizations are dropped and it can't be reserved again
+	_, err = handler.VerifyDomain(bob, squatted.ID.String())
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	_, err = handler.ReserveDomain(bob, ReserveDomainInput{OrganizationId: bobOrganization, Name: "app.acme.com"})
+	assert.ErrorIs(t, err, ErrDomainReserved)
+}
@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"errors"
+	"time"
+	"yuka/internal/models"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	invitationTokenPrefix = "yukainv_"
+	// defaultInvitationTTL is how long invitations created without an expiry can be accepted for
+	defaultInvitationTTL = 7 * 24 * time.Hour
+)
+
+var (
+	ErrInvitationNotFound     = errors.New("invitation not found")
+	ErrInvitationExpired      = errors.New("invitation has expired")
+	ErrInvitationAccepted     = errors.New("invitation has already been accepted")
+	ErrInvitationExpiryInPast = errors.New("invitation expiry must be in the future")
+)
+
+type CreateInvitationInput struct {
+	Email string `json:"email" binding:"required,email"`
+	// Role is one of owner, admin or member, member when it's not set. Only owners can invite other owners.
+	Role models.OrganizationRole `json:"role" binding:"omitempty,oneof=owner admin member" swaggertype:"string"`
+	// ExpiresAt is optional, invitations without it expire after a week
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// CreateInvitationResponse is the only time the invitation's token is returned
+type CreateInvitationResponse struct {
+	models.Invitation
+	Token string `json:"token" example:"yukainv_Xk3bQ2..."`
+}
+
+type AcceptInvitationInput struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type InvitationHandler struct {
+	Db     *gorm.DB
+	Logger *zap.Logger
+}
+
+func NewInvitationHandler(logger *zap.Logger, db *gorm.DB) InvitationHandler {
+	return InvitationHandler{
+		Db:     db,
+		Logger: logger,
+	}
+}
+
+// CreateInvitation creates an invitation to an organization, the user must be one of its owners or admins.
+// The token is only returned by this call.
+func (c *InvitationHandler) CreateInvitation(userId string, id string, input CreateInvitationInput) (*CreateInvitationResponse, error) {
+	role := input.Role
+	if role == "" {
+		role = models.OrganizationRoleMember
+	}
+	expiresAt := time.Now().Add(defaultInvitationTTL)
+	if input.ExpiresAt != nil {
+		if !input.ExpiresAt.After(time.Now()) {
+			return nil, ErrInvitationExpiryInPast
+		}
+		expiresAt = *input.ExpiresAt
+	}
+
+	organization, userRole, err := authorizeMember(c.Db, userId, id, models.OrganizationRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if !userRole.Includes(role) {
+		return nil, ErrOrganizationRoleRequired
+	}
+
+	createdBy, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, err
+	}
+	token, err := generateToken(invitationTokenPrefix)
+	if err != nil {
+		return nil, err
+	}
+	invitation := models.Invitation{
+		OrganizationId: organization.ID,
+		CreatedBy:      createdBy,
+		Email:          input.Email,
+		Role:           role,
+		TokenHash:      hashToken(token),
+		ExpiresAt:      expiresAt,
+	}
+	if err := c.Db.Create(&invitation).Error; err != nil {
+		return nil, err
+	}
+
+	c.Logger.Info("Created invitation", zap.Object("invitation", &invitation))
+	return &CreateInvitationResponse{
+		Invitation: invitation,
+		Token:      token,
+	}, nil
+}
+
+// FindPendingInvitations returns the invitations to an organization that can still be accepted, the user must
+// be one of its owners or admins
+func (c *InvitationHandler) FindPendingInvitations(userId string, id string) ([]models.Invitation, error) {
+	organization, _, err := authorizeMember(c.Db, userId, id, models.OrganizationRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	var invitations []models.Invitation
+	if err := c.Db.Where("organization_id = ? AND accepted_at IS NULL AND expires_at > ?", organization.ID, time.Now()).
+		Order("created_at").
+		Find(&invitations).Error; err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+// RevokeInvitation deletes a pending invitation to an organization, the user must be one of its owners or
+// admins. Returns gorm.ErrRecordNotFound if the organization has no such pending invitation.
+func (c *InvitationHandler) RevokeInvitation(userId string, id string, invitationId string) error {
+	return c.Db.Transaction(func(tx *gorm.DB) error {
+		organization, _, err := authorizeMember(tx, userId, id, models.OrganizationRoleAdmin)
+		if err != nil {
+			return err
+		}
+
+		var invitation models.Invitation
+		if err := tx.Where("id = ? AND organization_id = ? AND accepted_at IS NULL", invitationId, organization.ID).
+			First(&invitation).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&invitation).Error; err != nil {
+			return err
+		}
+
+		c.Logger.Info("Revoked invitation", zap.Object("invitation", &invitation))
+		return nil
+	})
+}
+
+// AcceptInvitation adds the user to the organization of the invitation with the token, which can't be used
+// again. The organization becomes the user's current organization if they don't have one yet.
+func (c *InvitationHandler) AcceptInvitation(userId string, input AcceptInvitationInput) (*models.Organization, error) {
+	var organization models.Organization
+	err := c.Db.Transaction(func(tx *gorm.DB) error {
+		var invitation models.Invitation
+		if err := tx.Where("token_hash = ?", hashToken(input.Token)).First(&invitation).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvitationNotFound
+			}
+			return err
+		}
+		if invitation.AcceptedAt != nil {
+			return ErrInvitationAccepted
+		}
+		if invitation.IsExpired() {
+			return ErrInvitationExpired
+		}
+
+		var user models.User
+		if err := tx.Where("id = ?", userId).First(&user).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("id = ?", invitation.OrganizationId).First(&organization).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrOrganizationNotFound
+			}
+			return err
+		}
+		if _, err := findMembership(tx, user.ID.String(), organization.ID.String()); err == nil {
+			return ErrAlreadyOrganizationMember
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		// Only one of any concurrent accepts gets to mark the invitation as accepted
+		result := tx.Model(&models.Invitation{}).
+			Where("id = ? AND accepted_at IS NULL", invitation.ID).
+			Updates(map[string]interface{}{"accepted_at": time.Now(), "accepted_by": user.ID})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrInvitationAccepted
+		}
+
+		membership := models.UserOrganization{
+			UserID:         user.ID,
+			OrganizationID: organization.ID,
+			Role:           invitation.Role,
+		}
+		if err := tx.Create(&membership).Error; err != nil {
+			return err
+		}
+		if user.CurrentOrganizationId == "" {
+			if err := tx.Model(&user).Update("current_organization_id", organization.ID.String()).Error; err != nil {
+				return err
+			}
+		}
+
+		c.Logger.Info("Accepted invitation", zap.Object("invitation", &invitation), zap.Object("membership", &membership))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &organization, nil
+}
@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+	"yuka/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func TestInvitationRoles(t *testing.T) {
+	db := newTestDatabase(t)
+	handler := NewInvitationHandler(zap.NewNop(), db)
+	owner := createTestUser(t, db, "owner")
+	id, members := createTestOrganization(t, db, owner, models.OrganizationRoleAdmin, models.OrganizationRoleMember)
+	admin, member := members[0], members[1]
+
+	_, err := handler.CreateInvitation(member, id, CreateInvitationInput{Email: "joiner@yuka.dev"})
+	assert.ErrorIs(t, err, ErrOrganizationRoleRequired)
+	_, err = handler.FindPendingInvitations(member, id)
+	assert.ErrorIs(t, err, ErrOrganizationRoleRequired)
+	// Invitations can't give a role above the one of the user creating them
+	_, err = handler.CreateInvitation(admin, id, CreateInvitationInput{Email: "joiner@yuka.dev", Role: models.OrganizationRoleOwner})
+	assert.ErrorIs(t, err, ErrOrganizationRoleRequired)
+
+	invitation, err := handler.CreateInvitation(admin, id, CreateInvitationInput{Email: "joiner@yuka.dev", Role: models.OrganizationRoleAdmin})
+	require.NoError(t, err)
+	assert.ErrorIs(t, handler.RevokeInvitation(member, id, invitation.ID.String()), ErrOrganizationRoleRequired)
+	assert.NoError(t, handler.RevokeInvitation(admin, id, invitation.ID.String()))
+	_, err = handler.AcceptInvitation(createTestUser(t, db, "joiner"), AcceptInvitationInput{Token: invitation.Token})
+	assert.ErrorIs(t, err, ErrInvitationNotFound)
+}
+
+func TestInvitationIsSingleUse(t *testing.T) {
+	db := newTestDatabase(t)
+	handler := NewInvitationHandler(zap.NewNop(), db)
+	organizationHandler := NewOrganizationHandler(zap.NewNop(), db)
+	owner := createTestUser(t, db, "owner")
+	id, _ := createTestOrganization(t, db, owner)
+	joiner := createTestUser(t, db, "joiner")
+
+	invitation, err := handler.CreateInvitation(owner, id, CreateInvitationInput{Email: "joiner@yuka.dev", Role: models.OrganizationRoleAdmin})
+	require.NoError(t, err)
+	organization, err := handler.AcceptInvitation(joiner, AcceptInvitationInput{Token: invitation.Token})
+	require.NoError(t, err)
+	assert.Equal(t, id, organization.ID.String())
+
+	_, err = handler.AcceptInvitation(joiner, AcceptInvitationInput{Token: invitation.Token})
+	assert.ErrorIs(t, err, ErrInvitationAccepted)
+	_, err = handler.AcceptInvitation(createTestUser(t, db, "other"), AcceptInvitationInput{Token: invitation.Token})
+	assert.ErrorIs(t, err, ErrInvitationAccepted)
+	assert.ErrorIs(t, handler.RevokeInvitation(owner, id, invitation.ID.String()), gorm.ErrRecordNotFound)
+	pending, err := handler.FindPendingInvitations(owner, id)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	members, err := organizationHandler.FindOrganizationMembers(joiner, id)
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	assert.Equal(t, models.OrganizationRoleAdmin, members[1].Role)
+	var user models.User
+	require.NoError(t, db.First(&user, "id = ?", joiner).Error)
+	assert.Equal(t, id, user.CurrentOrganizationId)
+}
+
+func TestInvitationExpires(t *testing.T) {
+	db := newTestDatabase(t)
+	handler := NewInvitationHandler(zap.NewNop(), db)
+	owner := createTestUser(t, db, "owner")
+	id, _ := createTestOrganization(t, db, owner)
+
+	past := time.Now().Add(-time.Minute)
+	_, err := handler.CreateInvitation(owner, id, CreateInvitationInput{Email: "joiner@yuka.dev", ExpiresAt: &past})
+	assert.ErrorIs(t, err, ErrInvitationExpiryInPast)
+
+	invitation, err := handler.CreateInvitation(owner, id, CreateInvitationInput{Email: "joiner@yuka.dev"})
+	require.NoError(t, err)
+	require.NoError(t, db.Model(&models.Invitation{}).Where("id = ?", invitation.ID).Update("expires_at", past).Error)
+	_, err = handler.AcceptInvitation(createTestUser(t, db, "joiner"), AcceptInvitationInput{Token: invitation.Token})
+	assert.ErrorIs(t, err, ErrInvitationExpired)
+	pending, err := handler.FindPendingInvitations(owner, id)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestInvitationAcceptedOnceConcurrently(t *testing.T) {
+	db := newTestDatabase(t)
+	handler := NewInvitationHandler(zap.NewNop(), db)
+	owner := createTestUser(t, db, "owner")
+	id, _ := createTestOrganization(t, db, owner)
+	invitation, err := handler.CreateInvitation(owner, id, CreateInvitationInput{Email: "joiner@yuka.dev"})
+	require.NoError(t, err)
+
+	const joiners = 10
+	errs := make([]error, joiners)
+	var wg sync.WaitGroup
+	for i := range errs {
+		joiner := createTestUser(t, db, fmt.Sprintf("joiner-%d", i))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = handler.AcceptInvitation(joiner, AcceptInvitationInput{Token: invitation.Token})
+		}()
+	}
+	wg.Wait()
+
+	accepted := 0
+	for _, err := range errs {
+		if err == nil {
+			accepted++
+			continue
+		}
+		assert.ErrorIs(t, err, ErrInvitationAccepted)
+	}
+	assert.Equal(t, 1, accepted)
+	organizationHandler := NewOrganizationHandler(zap.NewNop(), db)
+	members, err := organizationHandler.FindOrganizationMembers(owner, id)
+	require.NoError(t, err)
+	assert.Len(t, members, 2)
+}
@@ -0,0 +1,369 @@
+package handlers
+
+import (
+	"errors"
+	"time"
+	"yuka/internal/models"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrOrganizationNotFound      = errors.New("organization not found")
+	ErrNotOrganizationMember     = errors.New("user is not a member of the organization")
+	ErrOrganizationRoleRequired  = errors.New("user's role in the organization does not allow this")
+	ErrAlreadyOrganizationMember = errors.New("user is already a member of the organization")
+	ErrLastOrganizationOwner     = errors.New("organization must keep at least one owner")
+)
+
+type CreateOrganizationInput struct {
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+}
+
+type UpdateOrganizationInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type AddOrganizationMemberInput struct {
+	UserId string `json:"user_id" binding:"required,uuid"`
+	// Role is one of owner, admin or member, member when it's not set. Only owners can add other owners.
+	Role models.OrganizationRole `json:"role" binding:"omitempty,oneof=owner admin member" swaggertype:"string"`
+}
+
+type UpdateOrganizationMemberInput struct {
+	// Role is one of owner, admin or member. Only owners can promote to or demote from owner.
+	Role models.OrganizationRole `json:"role" binding:"required,oneof=owner admin member" swaggertype:"string"`
+}
+
+// OrganizationMember is a user along with their role in an organization
+type OrganizationMember struct {
+	UserId   uuid.UUID `json:"user_id" example:"aa22666c-0f57-45cb-a449-16efecc04f2e"`
+	Username string    `json:"username"`
+	// Role is one of owner, admin or member
+	Role     models.OrganizationRole `json:"role" swaggertype:"string"`
+	JoinedAt time.Time               `json:"joined_at"`
+}
+
+type OrganizationHandler struct {
+	Db     *gorm.DB
+	Logger *zap.Logger
+}
+
+func NewOrganizationHandler(logger *zap.Logger, db *gorm.DB) OrganizationHandler {
+	return OrganizationHandler{
+		Db:     db,
+		Logger: logger,
+	}
+}
+
+// CreateOrganization creates an organization owned by the user, it becomes the user's current organization
+// if they don't have one yet
+func (c *OrganizationHandler) CreateOrganization(userId string, input CreateOrganizationInput) (*models.Organization, error) {
+	organization := models.Organization{
+		Name:        input.Name,
+		Description: input.Description,
+	}
+	err := c.Db.Transaction(func(tx *gorm.DB) error {
+		var user models.User
+		if err := tx.Where("id = ?", userId).First(&user).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&organization).Error; err != nil {
+			return err
+		}
+		membership := models.UserOrganization{
+			UserID:         user.ID,
+			OrganizationID: organization.ID,
+			Role:           models.OrganizationRoleOwner,
+		}
+		if err := tx.Create(&membership).Error; err != nil {
+			return err
+		}
+		if user.CurrentOrganizationId == "" {
+			return tx.Model(&user).Update("current_organization_id", organization.ID.String()).Error
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Info("Created organization", zap.Object("organization", &organization))
+	return &organization, nil
+}
+
+// FindOrganizations returns every organization the user is a member of
+func (c *OrganizationHandler) FindOrganizations(userId string) ([]models.Organization, error) {
+	var organizations []models.Organization
+	if err := c.Db.Joins("JOIN user_organizations ON user_organizations.organization_id = organizations.id").
+		Where("user_organizations.user_id = ?", userId).
+		Find(&organizations).Error; err != nil {
+		return nil, err
+	}
+	return organizations, nil
+}
+
+// FindOrganization returns an organization the user is a member of
+func (c *OrganizationHandler) FindOrganization(userId string, id string) (*models.Organization, error) {
+	organization, _, err := authorizeMember(c.Db, userId, id, models.OrganizationRoleMember)
+	return organization, err
+}
+
+// UpdateOrganization updates an organization, the user must be one of its owners or admins
+func (c *OrganizationHandler) UpdateOrganization(userId string, id string, input UpdateOrganizationInput) (*models.Organization, error) {
+	organization, _, err := authorizeMember(c.Db, userId, id, models.OrganizationRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Db.Model(organization).Updates(input).Error; err != nil {
+		return nil, err
+	}
+
+	c.Logger.Info("Updated organization", zap.Object("organization", organization))
+	return organization, nil
+}
+
+// DeleteOrganization deletes an organization along with its memberships, invitations and reserved domains, the
+// user must be one of its owners
+func (c *OrganizationHandler) DeleteOrganization(userId string, id string) error {
+	return c.Db.Transaction(func(tx *gorm.DB) error {
+		organization, _, err := authorizeMember(tx, userId, id, models.OrganizationRoleOwner)
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("organization_id = ?", organization.ID).Delete(&models.UserOrganization{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("organization_id = ?", organization.ID).Delete(&models.Invitation{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("organization_id = ?", organization.ID).Delete(&models.Domain{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.User{}).Where("current_organization_id = ?", organization.ID.String()).
+			Update("current_organization_id", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(organization).Error; err != nil {
+			return err
+		}
+
+		c.Logger.Info("Deleted organization", zap.Object("organization", organization))
+		return nil
+	})
+}
+
+// FindOrganizationMembers returns the members of an organization the user is a member of
+func (c *OrganizationHandler) FindOrganizationMembers(userId string, id string) ([]OrganizationMember, error) {
+	organization, _, err := authorizeMember(c.Db, userId, id, models.OrganizationRoleMember)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []OrganizationMember
+	if err := c.Db.Model(&models.UserOrganization{}).
+		Select("user_organizations.user_id, users.username, user_organizations.role, user_organizations.created_at AS joined_at").
+		Joins("JOIN users ON users.id = user_organizations.user_id").
+		Where("user_organizations.organization_id = ?", organization.ID).
+		Order("user_organizations.created_at").
+		Scan(&members).Error; err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// AddOrganizationMember adds a user to an organization, the user adding them must be one of its owners or
+// admins. Returns gorm.ErrRecordNotFound if the user being added doesn't exist.
+func (c *OrganizationHandler) AddOrganizationMember(userId string, id string, input AddOrganizationMemberInput) (*OrganizationMember, error) {
+	role := input.Role
+	if role == "" {
+		role = models.OrganizationRoleMember
+	}
+
+	var member *OrganizationMember
+	err := c.Db.Transaction(func(tx *gorm.DB) error {
+		organization, userRole, err := authorizeMember(tx, userId, id, models.OrganizationRoleAdmin)
+		if err != nil {
+			return err
+		}
+		if !userRole.Includes(role) {
+			return ErrOrganizationRoleRequired
+		}
+
+		var user models.User
+		if err := tx.Where("id = ?", input.UserId).First(&user).Error; err != nil {
+			return err
+		}
+		if _, err := findMembership(tx, user.ID.String(), organization.ID.String()); err == nil {
+			return ErrAlreadyOrganizationMember
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		membership := models.UserOrganization{
+			UserID:         user.ID,
+			OrganizationID: organization.ID,
+			Role:           role,
+		}
+		if err := tx.Create(&membership).Error; err != nil {
+			return err
+		}
+
+		c.Logger.Info("Added organization member", zap.Object("membership", &membership))
+		member = &OrganizationMember{
+			UserId:   user.ID,
+			Username: user.Username,
+			Role:     membership.Role,
+			JoinedAt: membership.CreatedAt,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// RemoveOrganizationMember removes a user from an organization. Members can always remove themselves,
+// otherwise the user must be one of its owners or admins and only owners can remove other owners. Returns
+// gorm.ErrRecordNotFound if the user being removed isn't a member.
+func (c *OrganizationHandler) RemoveOrganizationMember(userId string, id string, memberId string) error {
+	return c.Db.Transaction(func(tx *gorm.DB) error {
+		requiredRole := models.OrganizationRoleAdmin
+		if userId == memberId {
+			requiredRole = models.OrganizationRoleMember
+		}
+		organization, userRole, err := authorizeMember(tx, userId, id, requiredRole)
+		if err != nil {
+			return err
+		}
+
+		membership, err := findMembership(tx, memberId, organization.ID.String())
+		if err != nil {
+			return err
+		}
+		if !userRole.Includes(membership.Role) {
+			return ErrOrganizationRoleRequired
+		}
+		if membership.Role == models.OrganizationRoleOwner {
+			if err := checkOtherOwner(tx, organization.ID); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Where("user_id = ? AND organization_id = ?", membership.UserID, membership.OrganizationID).
+			Delete(&models.UserOrganization{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.User{}).
+			Where("id = ? AND current_organization_id = ?", membership.UserID, organization.ID.String()).
+			Update("current_organization_id", nil).Error; err != nil {
+			return err
+		}
+
+		c.Logger.Info("Removed organization member", zap.Object("membership", membership))
+		return nil
+	})
+}
+
+// UpdateOrganizationMember changes the role of a member of an organization, the user must be one of its owners
+// or admins and only owners can promote to or demote from owner. The last owner can't be demoted. Returns
+// gorm.ErrRecordNotFound if the user being updated isn't a member.
+func (c *OrganizationHandler) UpdateOrganizationMember(userId string, id string, memberId string, input UpdateOrganizationMemberInput) (*OrganizationMember, error) {
+	var member *OrganizationMember
+	err := c.Db.Transaction(func(tx *gorm.DB) error {
+		organization, userRole, err := authorizeMember(tx, userId, id, models.OrganizationRoleAdmin)
+		if err != nil {
+			return err
+		}
+
+		membership, err := findMembership(tx, memberId, organization.ID.String())
+		if err != nil {
+			return err
+		}
+		if !userRole.Includes(membership.Role) || !userRole.Includes(input.Role) {
+			return ErrOrganizationRoleRequired
+		}
+		if membership.Role == models.OrganizationRoleOwner && input.Role != models.OrganizationRoleOwner {
+			if err := checkOtherOwner(tx, organization.ID); err != nil {
+				return err
+			}
+		}
+
+		var user models.User
+		if err := tx.Where("id = ?", membership.UserID).First(&user).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.UserOrganization{}).
+			Where("user_id = ? AND organization_id = ?", membership.UserID, membership.OrganizationID).
+			Update("role", input.Role).Error; err != nil {
+			return err
+		}
+		membership.Role = input.Role
+
+		c.Logger.Info("Updated organization member", zap.Object("membership", membership))
+		member = &OrganizationMember{
+			UserId:   user.ID,
+			Username: user.Username,
+			Role:     membership.Role,
+			JoinedAt: membership.CreatedAt,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// checkOtherOwner returns ErrLastOrganizationOwner unless the organization has more than one owner, so one of
+// them can stop being an owner
+func checkOtherOwner(tx *gorm.DB, organizationId uuid.UUID) error {
+	var owners int64
+	if err := tx.Model(&models.UserOrganization{}).
+		Where("organization_id = ? AND role = ?", organizationId, models.OrganizationRoleOwner).
+		Count(&owners).Error; err != nil {
+		return err
+	}
+	if owners <= 1 {
+		return ErrLastOrganizationOwner
+	}
+	return nil
+}
+
+// authorizeMember finds the organization and checks the user is a member whose role includes role, returning
+// the organization along with the user's role in it
+func authorizeMember(tx *gorm.DB, userId string, id string, role models.OrganizationRole) (*models.Organization, models.OrganizationRole, error) {
+	var organization models.Organization
+	if err := tx.Where("id = ?", id).First(&organization).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, "", ErrOrganizationNotFound
+		}
+		return nil, "", err
+	}
+
+	membership, err := findMembership(tx, userId, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, "", ErrNotOrganizationMember
+		}
+		return nil, "", err
+	}
+	if !membership.Role.Includes(role) {
+		return nil, "", ErrOrganizationRoleRequired
+	}
+	return &organization, membership.Role, nil
+}
+
+func findMembership(tx *gorm.DB, userId string, organizationId string) (*models.UserOrganization, error) {
+	var membership models.UserOrganization
+	if err := tx.Where("user_id = ? AND organization_id = ?", userId, organizationId).First(&membership).Error; err != nil {
+		return nil, err
+	}
+	return &membership, nil
+}
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"fmt"
+	"testing"
+	"yuka/internal/database"
+	"yuka/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func newTestDatabase(t *testing.T) *gorm.DB {
+	db, err := database.ConnectTestDatabase(zap.NewNop())
+	require.NoError(t, err)
+	return db
+}
+
+func createTestUser(t *testing.T, db *gorm.DB, username string) string {
+	handler := NewUserHandler(zap.NewNop(), db)
+	user, err := handler.CreateUser(CreateUserInput{AuthID: username, Username: username, DeviceToken: username})
+	require.NoError(t, err)
+	return user.ID.String()
+}
+
+// createTestOrganization creates an organization owned by owner with a member for each of the given roles,
+// returning its id along with the ids of the members
+func createTestOrganization(t *testing.T, db *gorm.DB, owner string, roles ...models.OrganizationRole) (string, []string) {
+	handler := NewOrganizationHandler(zap.NewNop(), db)
+	organization, err := handler.CreateOrganization(owner, CreateOrganizationInput{Name: "acme"})
+	require.NoError(t, err)
+	var members []string
+	for _, role := range roles {
+		member := createTestUser(t, db, fmt.Sprintf("%s-%s-%d", organization.ID, role, len(members)))
+		_, err := handler.AddOrganizationMember(owner, organization.ID.String(), AddOrganizationMemberInput{UserId: member, Role: role})
+		require.NoError(t, err)
+		members = append(members, member)
+	}
+	return organization.ID.String(), members
+}
+
+func TestOrganizationRoles(t *testing.T) {
+	db := newTestDatabase(t)
+	handler := NewOrganizationHandler(zap.NewNop(), db)
+	owner := createTestUser(t, db, "owner")
+	id, members := createTestOrganization(t, db, owner, models.OrganizationRoleAdmin, models.OrganizationRoleMember)
+	admin, member := members[0], members[1]
+	outsider := createTestUser(t, db, "outsider")
+	newcomer := createTestUser(t, db, "newcomer")
+	update := UpdateOrganizationInput{Description: "updated"}
+
+	// Members can only see the organization
+	_, err := handler.FindOrganization(member, id)
+	assert.NoError(t, err)
+	_, err = handler.FindOrganizationMembers(member, id)
+	assert.NoError(t, err)
+	_, err = handler.UpdateOrganization(member, id, update)
+	assert.ErrorIs(t, err, ErrOrganizationRoleRequired)
+	_, err = handler.AddOrganizationMember(member, id, AddOrganizationMemberInput{UserId: newcomer})
+	assert.ErrorIs(t, err, ErrOrganizationRoleRequired)
+	assert.ErrorIs(t, handler.RemoveOrganizationMember(member, id, admin), ErrOrganizationRoleRequired)
+	assert.ErrorIs(t, handler.DeleteOrganization(member, id), ErrOrganizationRoleRequired)
+
+	// Admins manage the organization and its members, but not its owners
+	_, err = handler.UpdateOrganization(admin, id, update)
+	assert.NoError(t, err)
+	_, err = handler.AddOrganizationMember(admin, id, AddOrganizationMemberInput{UserId: newcomer, Role: models.OrganizationRoleOwner})
+	assert.ErrorIs(t, err, ErrOrganizationRoleRequired)
+	_, err = handler.AddOrganizationMember(admin, id, AddOrganizationMemberInput{UserId: newcomer})
+	assert.NoError(t, err)
+	_, err = handler.AddOrganizationMember(admin, id, AddOrganizationMemberInput{UserId: newcomer})
+	assert.ErrorIs(t, err, ErrAlreadyOrganizationMember)
+	assert.ErrorIs(t, handler.RemoveOrganizationMember(admin, id, owner), ErrOrganizationRoleRequired)
+	assert.NoError(t, handler.RemoveOrganizationMember(admin, id, newcomer))
+	assert.ErrorIs(t, handler.DeleteOrganization(admin, id), ErrOrganizationRoleRequired)
+
+	// Users outside the organization can't see it at all
+	_, err = handler.FindOrganization(outsider, id)
+	assert.ErrorIs(t, err, ErrNotOrganizationMember)
+	_, err = handler.FindOrganizationMembers(outsider, id)
+	assert.ErrorIs(t, err, ErrNotOrganizationMember)
+	organizations, err := handler.FindOrganizations(outsider)
+	require.NoError(t, err)
+	assert.Empty(t, organizations)
+
+	// Members can leave on their own
+	assert.NoError(t, handler.RemoveOrganizationMember(member, id, member))
+	_, err = handler.FindOrganization(member, id)
+	assert.ErrorIs(t, err, ErrNotOrganizationMember)
+	var memberUser models.User
+	require.NoError(t, db.First(&memberUser, "id = ?", member).Error)
+	assert.Empty(t, memberUser.CurrentOrganizationId)
+
+	assert.NoError(t, handler.DeleteOrganization(owner, id))
+	_, err = handler.FindOrganization(owner, id)
+	assert.ErrorIs(t, err, ErrOrganizationNotFound)
+	var ownerUser models.User
+	require.NoError(t, db.First(&ownerUser, "id = ?", owner).Error)
+	assert.Empty(t, ownerUser.CurrentOrganizationId)
+}
+
+func TestOrganizationKeepsAnOwner(t *testing.T) {
+	db := newTestDatabase(t)
+	handler := NewOrganizationHandler(zap.NewNop(), db)
+	owner := createTestUser(t, db, "owner")
+	id, members := createTestOrganization(t, db, owner, models.OrganizationRoleAdmin)
+	admin := members[0]
+	toAdmin := UpdateOrganizationMemberInput{Role: models.OrganizationRoleAdmin}
+	toOwner := UpdateOrganizationMemberInput{Role: models.OrganizationRoleOwner}
+
+	// The only owner can neither leave nor be demoted
+	assert.ErrorIs(t, handler.RemoveOrganizationMember(owner, id, owner), ErrLastOrganizationOwner)
+	_, err := handler.UpdateOrganizationMember(owner, id, owner, toAdmin)
+	assert.ErrorIs(t, err, ErrLastOrganizationOwner)
+
+	// Admins can't demote owners nor promote themselves to owner
+	_, err = handler.UpdateOrganizationMember(admin, id, owner, toAdmin)
+	assert.ErrorIs(t, err, ErrOrganizationRoleRequired)
+	_, err = handler.UpdateOrganizationMember(admin, id, admin, toOwner)
+	assert.ErrorIs(t, err, ErrOrganizationRoleRequired)
+
+	// Once there's another owner the first one can step down
+	promoted, err := handler.UpdateOrganizationMember(owner, id, admin, toOwner)
+	require.NoError(t, err)
+	assert.Equal(t, models.OrganizationRoleOwner, promoted.Role)
+	demoted, err := handler.UpdateOrganizationMember(owner, id, owner, toAdmin)
+	require.NoError(t, err)
+	assert.Equal(t, models.OrganizationRoleAdmin, demoted.Role)
+	assert.ErrorIs(t, handler.DeleteOrganization(owner, id), ErrOrganizationRoleRequired)
+
+	// Which leaves the new owner as the last one
+	assert.ErrorIs(t, handler.RemoveOrganizationMember(admin, id, admin), ErrLastOrganizationOwner)
+	_, err = handler.UpdateOrganizationMember(admin, id, admin, toAdmin)
+	assert.ErrorIs(t, err, ErrLastOrganizationOwner)
+	assert.NoError(t, handler.RemoveOrganizationMember(owner, id, owner))
+
+	remaining, err := handler.FindOrganizationMembers(admin, id)
+	require.NoError(t, err)
+	require.Len(t, remaining, 1)
+	assert.Equal(t, models.OrganizationRoleOwner, remaining[0].Role)
+	_, err = handler.UpdateOrganizationMember(admin, id, owner, toAdmin)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
@@ -1,9 +1,15 @@
 package handlers
 
 import (
+	"bufio"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"strings"
+	"yuka/internal/consts"
+	"yuka/internal/models"
+	"yuka/pkg/http_helper"
 	"yuka/pkg/streaming_connection"
 
 	"github.com/gin-gonic/gin"
@@ -18,10 +24,10 @@ type TunnelRequestResp struct {
 type TunnelHandler struct {
 	db             *gorm.DB
 	slogger        *zap.SugaredLogger
-	connectionPool streaming_connection.StreamingConnectionPool
+	connectionPool *streaming_connection.StreamingConnectionPool
 }
 
-func NewTunnelHandler(logger *zap.Logger, db *gorm.DB, connectionPool streaming_connection.StreamingConnectionPool) TunnelHandler {
+func NewTunnelHandler(logger *zap.Logger, db *gorm.DB, connectionPool *streaming_connection.StreamingConnectionPool) TunnelHandler {
 	return TunnelHandler{
 		db:             db,
 		slogger:        logger.Sugar(),
@@ -66,38 +72,205 @@ func NewTunnelHandler(logger *zap.Logger, db *gorm.DB, connectionPool streaming_
 // }
 
 func (self *TunnelHandler) TunnelRequest(c *gin.Context) error {
-	// url := getUrlForRequest(c)
-	fmt.Println("Host is ", c.Request.Host)
-	registeredHostname := "seb-hostname"
-	// TODO: This should come from the request
-	connection, err := self.connectionPool.GetConnection(registeredHostname)
+	host := getHostForRequest(c)
+	connection, registeredHostname, err := self.connectionPool.SelectConnectionForHost(host, c.ClientIP())
 	if err != nil {
-		self.slogger.Warnf("Received error when getting connection for hostname %s: %v", registeredHostname, err)
+		self.slogger.Warnf("Received error when getting connection for host %s: %v", host, err)
+		c.JSON(http.StatusNotFound, models.NewNotFoundError("tunnel"))
+		return err
+	}
+	if !connection.IsOpen() {
+		self.slogger.Warnf("Connection for hostname %s is no longer open", registeredHostname)
+		c.JSON(http.StatusBadGateway, models.NewBadGatewayError("agent is not connected"))
+		return streaming_connection.ErrConnectionNotFound
 	}
-	c.Request.Header.Set("Connection", "Close")
-	writeRequestMetadata(c, connection.GetWriter())
+	self.slogger.Debugf("Tunneling request for host %s to hostname %s", host, registeredHostname)
 
-	// Stream the request body to the TCP server
-	_, err = io.Copy(connection.GetWriter(), c.Request.Body)
-	println("Finished copying data")
+	// Every request gets its own stream so concurrent requests to the same agent don't interleave
+	stream, err := connection.OpenStream()
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to stream body to TCP server"})
+		self.slogger.Errorf("Error opening stream to hostname %s: %v", registeredHostname, err)
+		c.JSON(http.StatusBadGateway, models.NewBadGatewayError("failed to open stream to agent"))
 		return err
 	}
 
-	// Stream data from the connection directly to the response writer
-	if _, err := io.Copy(c.Writer, connection.GetReader()); err != nil {
-		self.slogger.Errorf("Error streaming response: %v", err)
-		c.JSON(500, gin.H{"error": "Error streaming response"})
-		return nil
+	req := newTunneledRequest(c)
+	upgradeType := http_helper.UpgradeType(req.Header)
+
+	// The request is written while the response is read so the agent can answer before the whole body has
+	// been sent, i.e. when rejecting an upload
+	var writeErr error
+	writeDone := make(chan struct{})
+	go func() {
+		defer close(writeDone)
+		writeErr = req.Write(stream)
+		if writeErr == nil && upgradeType == "" {
+			// There's only ever one request per stream, closing our side tells the agent not to wait for more.
+			// Upgrades keep it open as the stream carries the new protocol once the agent agrees to switch.
+			writeErr = stream.CloseWrite()
+		}
+		if writeErr != nil {
+			// The agent would otherwise be left waiting for the rest of the request
+			stream.Close()
+		}
+	}()
+	// The request body belongs to the server once we return so the write has to be over by then, closing
+	// the stream first stops it if the agent answered without reading the whole body
+	defer func() {
+		stream.Close()
+		<-writeDone
+	}()
+
+	streamReader := bufio.NewReader(stream)
+	resp, err := http.ReadResponse(streamReader, req)
+	if err != nil {
+		stream.Close()
+		<-writeDone
+		if writeErr != nil {
+			err = writeErr
+		}
+		self.slogger.Errorf("Error reading response from hostname %s: %v", registeredHostname, err)
+		c.JSON(http.StatusBadGateway, models.NewBadGatewayError("failed to read response from agent"))
+		return err
+	}
+	if resp.StatusCode == http.StatusSwitchingProtocols {
+		return self.switchProtocols(c, streaming_connection.NewPeekedConn(stream, streamReader), resp, upgradeType)
 	}
+	defer resp.Body.Close()
 
-	self.slogger.Info("Streaming completed successfully.")
-	c.Status(200) // Send a 200 OK status after completion
+	header := c.Writer.Header()
+	http_helper.RemoveHopByHopHeaders(resp.Header)
+	for key, values := range resp.Header {
+		header[key] = values
+	}
+	// Trailers have to be announced before the body is written for them to be sent after it
+	for key := range resp.Trailer {
+		header.Add("Trailer", key)
+	}
+	c.Writer.WriteHeader(resp.StatusCode)
 
+	// The headers have been sent so from here on errors can only be logged
+	if err := copyResponseBody(c.Writer, resp); err != nil {
+		self.slogger.Errorf("Error streaming response from hostname %s: %v", registeredHostname, err)
+		c.Abort()
+		return err
+	}
+	for key, values := range resp.Trailer {
+		header[key] = values
+	}
 	return nil
 }
 
+// switchProtocols relays the agent agreeing to upgrade the request then hands the client's connection over
+// to the new protocol by splicing it to stream
+func (self *TunnelHandler) switchProtocols(c *gin.Context, stream net.Conn, resp *http.Response, upgradeType string) error {
+	respUpgradeType := http_helper.UpgradeType(resp.Header)
+	if upgradeType == "" || !strings.EqualFold(respUpgradeType, upgradeType) {
+		err := fmt.Errorf("agent switched protocol to %q when %q was requested", respUpgradeType, upgradeType)
+		self.slogger.Warnf("Error upgrading request: %v", err)
+		c.JSON(http.StatusBadGateway, models.NewBadGatewayError("agent switched to an unexpected protocol"))
+		return err
+	}
+
+	conn, clientReadWriter, err := c.Writer.Hijack()
+	if err != nil {
+		self.slogger.Errorf("Error hijacking connection to upgrade to %s: %v", upgradeType, err)
+		c.JSON(http.StatusInternalServerError, models.NewApiInternalError(err))
+		return err
+	}
+
+	http_helper.RemoveHopByHopHeaders(resp.Header)
+	resp.Header.Set("Connection", "Upgrade")
+	resp.Header.Set("Upgrade", respUpgradeType)
+	err = http_helper.WriteSwitchingProtocols(clientReadWriter, resp.Header)
+	if err == nil {
+		err = clientReadWriter.Flush()
+	}
+	if err != nil {
+		self.slogger.Warnf("Error writing upgrade response: %v", err)
+		conn.Close()
+		return err
+	}
+
+	self.slogger.Debugf("Switched protocol to %s for host %s", upgradeType, c.Request.Host)
+	// The client may have sent data for the new protocol straight after the request which will have been
+	// buffered along with it
+	err = streaming_connection.Splice(streaming_connection.NewPeekedConn(conn, clientReadWriter.Reader), stream)
+	if err != nil {
+		self.slogger.Debugf("Upgraded connection for host %s finished with: %v", c.Request.Host, err)
+	}
+	return nil
+}
+
+// newTunneledRequest returns the request that's forwarded to the agent, it's the incoming request without
+// the headers that only apply to the connection between the client and us
+func newTunneledRequest(c *gin.Context) *http.Request {
+	req := c.Request.Clone(c.Request.Context())
+	// The override header is only meaningful to yuka so there's no need to forward it on
+	req.Header.Del(consts.YukaHeaderHostname)
+	upgradeType := http_helper.UpgradeType(req.Header)
+	http_helper.RemoveHopByHopHeaders(req.Header)
+	if upgradeType != "" {
+		// An upgrade only works if the headers asking for it reach the local service
+		req.Header.Set("Connection", "Upgrade")
+		req.Header.Set("Upgrade", upgradeType)
+	}
+	// Stop Write adding Go's default user agent when the client didn't send one
+	if _, ok := req.Header["User-Agent"]; !ok {
+		req.Header.Set("User-Agent", "")
+	}
+
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	// The peer is appended rather than ClientIP as that's taken from X-Forwarded-For itself
+	if clientIP, _, err := net.SplitHostPort(c.Request.RemoteAddr); err == nil {
+		if prior := req.Header.Values("X-Forwarded-For"); len(prior) > 0 {
+			clientIP = strings.Join(prior, ", ") + ", " + clientIP
+		}
+		req.Header.Set("X-Forwarded-For", clientIP)
+	}
+	req.Header.Set("X-Forwarded-Host", c.Request.Host)
+	req.Header.Set("X-Forwarded-Proto", scheme)
+	return req
+}
+
+// copyResponseBody copies the body of resp to w. Bodies without a known length, such as server-sent
+// events, are flushed as they arrive rather than being held in the response writer's buffer.
+func copyResponseBody(w gin.ResponseWriter, resp *http.Response) error {
+	if resp.ContentLength != -1 {
+		_, err := io.Copy(w, resp.Body)
+		return err
+	}
+
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := resp.Body.Read(buf)
+		if n > 0 {
+			if _, err := w.Write(buf[:n]); err != nil {
+				return err
+			}
+			w.Flush()
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// getHostForRequest returns the host the request should be tunneled to. The yuka hostname header takes
+// precedence over the Host header so that clients can target a tunnel without DNS being set up.
+func getHostForRequest(c *gin.Context) string {
+	if hostname := c.GetHeader(consts.YukaHeaderHostname); hostname != "" {
+		return hostname
+	}
+	return c.Request.Host
+}
+
 // getUrlForRequest returns the URL in the format <schema>://<host><uri>
 //
 // example: http://localhost:8081/healthz
@@ -117,18 +290,3 @@ func getUrlForRequest(c *gin.Context) string {
 	return fmt.Sprintf("%s://%s%s", scheme, host, uri)
 
 }
-
-func writeRequestMetadata(c *gin.Context, writer io.Writer) {
-	// Write the request method and URL
-	fmt.Fprintf(writer, "%s %s %s\r\n", c.Request.Method, c.Request.URL.Path, c.Request.Proto)
-
-	// Write the headers
-	for key, values := range c.Request.Header {
-		for _, value := range values {
-			fmt.Fprintf(writer, "%s: %s\r\n", key, value)
-		}
-	}
-
-	// End of headers
-	fmt.Fprint(writer, "\r\n")
-}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"yuka/internal/models"
+	"yuka/pkg/streaming_connection"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type TunnelSessionHandler struct {
+	Db     *gorm.DB
+	Logger *zap.Logger
+	// Instance identifies this server among the servers sharing the database
+	Instance string
+}
+
+func NewTunnelSessionHandler(logger *zap.Logger, db *gorm.DB, instance string) TunnelSessionHandler {
+	return TunnelSessionHandler{
+		Db:       db,
+		Logger:   logger,
+		Instance: instance,
+	}
+}
+
+// TunnelStarted implements streaming_connection.TunnelRecorder, persisting the session
+func (c *TunnelSessionHandler) TunnelStarted(session *streaming_connection.TunnelSession) error {
+	tunnelSession := models.TunnelSession{
+		AgentVersion:   session.AgentVersion,
+		AgentAddress:   session.AgentAddress,
+		ServerInstance: c.Instance,
+		Protocol:       session.Protocol,
+		Hostname:       session.Hostname,
+		PublicURL:      session.PublicURL,
+		StartedAt:      session.StartedAt,
+	}
+	if session.Identity != nil {
+		tunnelSession.UserId = session.Identity.UserID
+		tunnelSession.OrganizationId = session.Identity.OrganizationID
+	}
+	if err := c.Db.Create(&tunnelSession).Error; err != nil {
+		return err
+	}
+
+	session.ID = tunnelSession.ID.String()
+	c.Logger.Debug("Started tunnel session", zap.Object("tunnelSession", &tunnelSession))
+	return nil
+}
+
+// TunnelEnded implements streaming_connection.TunnelRecorder, deleting the session
+func (c *TunnelSessionHandler) TunnelEnded(session *streaming_connection.TunnelSession) error {
+	if err := c.Db.Where("id = ?", session.ID).Delete(&models.TunnelSession{}).Error; err != nil {
+		return err
+	}
+
+	c.Logger.Sugar().Debugf("Ended tunnel session %s for hostname %s", session.ID, session.Hostname)
+	return nil
+}
+
+// DeleteInstanceSessions deletes the sessions left behind by a previous run of this server, their agents
+// went away along with it
+func (c *TunnelSessionHandler) DeleteInstanceSessions() error {
+	result := c.Db.Where("server_instance = ?", c.Instance).Delete(&models.TunnelSession{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		c.Logger.Sugar().Infof("Deleted %d stale tunnel sessions of instance %s", result.RowsAffected, c.Instance)
+	}
+	return nil
+}
+
+// FindTunnelSessions returns the sessions of the user's agents along with those of the agents acting for
+// the organizations the user is a member of
+func (c *TunnelSessionHandler) FindTunnelSessions(userId string) ([]models.TunnelSession, error) {
+	var tunnelSessions []models.TunnelSession
+	if err := c.Db.Where("user_id = ?", userId).
+		Or("organization_id IN (?)", c.Db.Model(&models.UserOrganization{}).Select("organization_id").Where("user_id = ?", userId)).
+		Order("started_at").
+		Find(&tunnelSessions).Error; err != nil {
+		return nil, err
+	}
+	return tunnelSessions, nil
+}
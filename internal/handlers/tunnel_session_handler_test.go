@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+	"yuka/internal/models"
+	"yuka/pkg/streaming_connection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestTunnelSessionsArePersisted(t *testing.T) {
+	db := newTestDatabase(t)
+	alice := createTestUser(t, db, "alice")
+	organization, members := createTestOrganization(t, db, alice, models.OrganizationRoleMember)
+	outsider := createTestUser(t, db, "outsider")
+	// Both servers share the database
+	first := NewTunnelSessionHandler(zap.NewNop(), db, "first")
+	second := NewTunnelSessionHandler(zap.NewNop(), db, "second")
+
+	startedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	organizationSession := &streaming_connection.TunnelSession{
+		Identity:     &streaming_connection.AgentIdentity{UserID: alice, OrganizationID: organization},
+		AgentVersion: "1.0.0",
+		Protocol:     "http",
+		Hostname:     "acme",
+		PublicURL:    "http://acme.yuka.dev",
+		StartedAt:    startedAt,
+	}
+	userSession := &streaming_connection.TunnelSession{
+		Identity:  &streaming_connection.AgentIdentity{UserID: alice},
+		Protocol:  "tcp",
+		Hostname:  "20000",
+		StartedAt: startedAt.Add(time.Second),
+	}
+	anonymousSession := &streaming_connection.TunnelSession{Protocol: "http", Hostname: "anonymous", StartedAt: startedAt}
+	require.NoError(t, first.TunnelStarted(organizationSession))
+	require.NoError(t, second.TunnelStarted(userSession))
+	require.NoError(t, second.TunnelStarted(anonymousSession))
+	assert.NotEmpty(t, organizationSession.ID)
+
+	sessions, err := first.FindTunnelSessions(alice)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, organizationSession.ID, sessions[0].ID.String())
+	assert.Equal(t, "first", sessions[0].ServerInstance)
+	assert.Equal(t, "http://acme.yuka.dev", sessions[0].PublicURL)
+	assert.True(t, startedAt.Equal(sessions[0].StartedAt))
+	assert.Equal(t, "second", sessions[1].ServerInstance)
+	// Other members of the organization only see its tunnels
+	sessions, err = first.FindTunnelSessions(members[0])
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "acme", sessions[0].Hostname)
+	sessions, err = first.FindTunnelSessions(outsider)
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+
+	require.NoError(t, first.TunnelEnded(organizationSession))
+	sessions, err = first.FindTunnelSessions(alice)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "20000", sessions[0].Hostname)
+
+	// A restarted server only clears the sessions it left behind
+	require.NoError(t, first.TunnelStarted(organizationSession))
+	require.NoError(t, second.DeleteInstanceSessions())
+	var remaining []models.TunnelSession
+	require.NoError(t, db.Find(&remaining).Error)
+	require.Len(t, remaining, 1)
+	assert.Equal(t, "first", remaining[0].ServerInstance)
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"yuka/internal/models"
 
@@ -8,19 +9,32 @@ import (
 	"gorm.io/gorm"
 )
 
+// CreateUserInput doesn't take an organization, users get their current organization by creating or
+// being added to one
 type CreateUserInput struct {
-	AuthID                string `json:"auth_id" binding:"required"`
-	CurrentOrganizationId string `json:"current_organization_id" binding:"required,uuid4"`
-	Username              string `json:"username" binding:"required"`
-	DeviceToken           string `json:"device_token" binding:"required"`
+	AuthID      string `json:"auth_id" binding:"required"`
+	Username    string `json:"username" binding:"required"`
+	DeviceToken string `json:"device_token" binding:"required"`
+}
+
+// CreateUserResponse is the only time the user's first API token is returned
+type CreateUserResponse struct {
+	models.User
+	Token string `json:"token" example:"yuka_Xk3bQ2..."`
 }
 
 type UpdateUserInput struct {
-	Username              string `json:"username"`
-	DeviceToken           string `json:"device_token"`
-	CurrentOrganizationId string `json:"current_organization_id"`
+	Username    string `json:"username"`
+	DeviceToken string `json:"device_token"`
+	// CurrentOrganizationId must be an organization the user is a member of
+	CurrentOrganizationId string `json:"current_organization_id" binding:"omitempty,uuid"`
 }
 
+// initialApiTokenName is the name of the API token users are created with
+const initialApiTokenName = "initial"
+
+var ErrUserExists = errors.New("user already exists")
+
 type UserKey string
 
 const (
@@ -55,17 +69,14 @@ func (c *UserHandler) FindUsers(key UserKey, val string) ([]models.User, error)
 	return users, nil
 }
 
-// CreateUser creates a User if it doesnt exist otherwise returns the existing user from the database
-func (c *UserHandler) CreateUser(input CreateUserInput) (*models.User, error) {
+// CreateUser creates a User along with their first API token, further tokens are created with it. Returns
+// ErrUserExists if a user with the same auth id already exists.
+func (c *UserHandler) CreateUser(input CreateUserInput) (*CreateUserResponse, error) {
 	var existingUser models.User
-	if err := c.Db.Where("auth_id = ?", input.AuthID).First(&existingUser).Error; err != nil {
-		if err != gorm.ErrRecordNotFound {
-			return nil, err
-		}
-		// If we have no user then we need to create one and so we can proceed
-	} else {
-		// We already have a user so just return it
-		return &existingUser, nil
+	if err := c.Db.Where("auth_id = ?", input.AuthID).First(&existingUser).Error; err == nil {
+		return nil, ErrUserExists
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
 	}
 
 	user := models.User{
@@ -73,9 +84,27 @@ func (c *UserHandler) CreateUser(input CreateUserInput) (*models.User, error) {
 		Username:    input.Username,
 		DeviceToken: input.DeviceToken,
 	}
+	var token string
+	err := c.Db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&user).Error; err != nil {
+			return err
+		}
+		apiToken, t, err := newApiToken(user.ID, CreateApiTokenInput{Name: initialApiTokenName})
+		if err != nil {
+			return err
+		}
+		token = t
+		return tx.Create(&apiToken).Error
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	c.Logger.Info("Created user", zap.Object("user", &user))
-	return &user, nil
+	return &CreateUserResponse{
+		User:  user,
+		Token: token,
+	}, nil
 }
 
 // FindUser returns the user along with their associated organizations
@@ -98,6 +127,14 @@ func (c *UserHandler) UpdateUser(id string, input UpdateUserInput) (*models.User
 		slogger.Errorf("Error finding user %s", id)
 		return nil, err
 	}
+	if input.CurrentOrganizationId != "" {
+		if _, err := findMembership(c.Db, id, input.CurrentOrganizationId); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, ErrNotOrganizationMember
+			}
+			return nil, err
+		}
+	}
 
 	if err := c.Db.Model(&user).Updates(input).Error; err != nil {
 		return nil, err
@@ -1,8 +1,6 @@
 package handlers
 
 import (
-	"strings"
-	"time"
 	"yuka/pkg/streaming_connection"
 
 	"github.com/gin-gonic/gin"
@@ -12,53 +10,36 @@ import (
 )
 
 var upgrader = websocket.Upgrader{
-	ReadBufferSize:  1024,
-	WriteBufferSize: 1024,
+	// Each write to the connection is a multiplexed frame so the buffers are sized to fit one
+	ReadBufferSize:  32 * 1024,
+	WriteBufferSize: 32 * 1024,
 }
 
-// WsHandler just exposes a basic websocket gateway that returns responses
+// WsHandler accepts agent connections over websockets, for agents that can't reach the tunnel listener
+// directly such as those behind an HTTP proxy
 type WsHandler struct {
-	db             *gorm.DB
-	slogger        *zap.SugaredLogger
-	connectionPool *streaming_connection.StreamingConnectionPool
+	db      *gorm.DB
+	slogger *zap.SugaredLogger
+	tunnel  *streaming_connection.TcpTunnel
 }
 
-func NewWsHandler(logger *zap.Logger, db *gorm.DB) WsHandler {
+func NewWsHandler(logger *zap.Logger, db *gorm.DB, tunnel *streaming_connection.TcpTunnel) WsHandler {
 	return WsHandler{
 		db:      db,
 		slogger: logger.Sugar(),
+		tunnel:  tunnel,
 	}
 }
 
+// HandleWsConnection upgrades the request to a websocket and serves it as an agent connection
 func (s *WsHandler) HandleWsConnection(c *gin.Context) error {
 	s.slogger.Info("Received new WS connection")
 
 	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
 	if err != nil {
+		// The upgrader has already responded with the error
 		s.slogger.Errorf("Error when upgrading connection request: %v", err)
-		return nil
+		return err
 	}
-
-	for {
-		_, message, err := conn.ReadMessage()
-		if err != nil {
-			// Occurs when a connection is closed (Can possibly look at a better way to handle this later...)
-			if strings.Contains(err.Error(), "close 1000 (normal)") {
-				s.slogger.Info("Client websocket connection closed")
-			} else {
-				s.slogger.Errorf("Error occurred when reading message: %v", err)
-			}
-			return err
-		}
-
-		s.slogger.Infof("Received message %s", message)
-
-		err = conn.WriteMessage(websocket.TextMessage, []byte("Pong"))
-		if err != nil {
-			s.slogger.Errorf("Write error %v", err)
-			break
-		}
-		time.Sleep(time.Second)
-	}
-	return nil
+	return s.tunnel.ServeWebSocket(conn)
 }
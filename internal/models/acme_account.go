@@ -0,0 +1,9 @@
+package models
+
+// AcmeAccount is the account certificates are obtained with from an ACME CA, i.e Let's Encrypt
+type AcmeAccount struct {
+	Base
+	// DirectoryURL identifies the CA the account is registered with
+	DirectoryURL  string `json:"directory_url" gorm:"uniqueIndex"`
+	PrivateKeyPEM string `json:"-"`
+}
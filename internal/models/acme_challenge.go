@@ -0,0 +1,9 @@
+package models
+
+// AcmeChallenge is the response to a pending HTTP-01 challenge, it's stored so the challenge can be answered
+// by whichever server instance the CA's request lands on
+type AcmeChallenge struct {
+	Base
+	Token            string `json:"token" gorm:"uniqueIndex"`
+	KeyAuthorization string `json:"-"`
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap/zapcore"
+)
+
+// ApiToken authenticates a user's agents when they connect to the tunnel server. Only the hash of the token
+// is stored, the token itself is shown once when it's created.
+type ApiToken struct {
+	Base
+	// FK id of the user that owns the token
+	UserId uuid.UUID `json:"user_id" gorm:"type:uuid;index"`
+	Name   string    `json:"name"`
+	// Prefix is the start of the token so users can tell their tokens apart
+	Prefix     string     `json:"prefix" example:"yuka_Xk3b"`
+	TokenHash  string     `json:"-" gorm:"uniqueIndex"`
+	LastUsedAt *time.Time `json:"last_used_at" gorm:"type:timestamptz;default:null"`
+	ExpiresAt  *time.Time `json:"expires_at" gorm:"type:timestamptz;default:null"`
+}
+
+// IsExpired checks if the token has an expiry that has passed
+func (c *ApiToken) IsExpired() bool {
+	return c.ExpiresAt != nil && time.Now().After(*c.ExpiresAt)
+}
+
+func (c *ApiToken) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("Id", c.ID.String())
+	enc.AddString("UserId", c.UserId.String())
+	enc.AddString("Name", c.Name)
+	enc.AddString("Prefix", c.Prefix)
+	return nil
+}
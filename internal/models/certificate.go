@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// Certificate is a certificate obtained with ACME for the tunnel router's HTTPS listener. It's stored so
+// every server instance serves the same certificate rather than each obtaining its own.
+type Certificate struct {
+	Base
+	// Name is the domain the certificate is for, or "*.<domain>" for a wildcard certificate
+	Name string `json:"name" gorm:"uniqueIndex" example:"*.yuka.dev"`
+	// CertificatePEM is the PEM encoded chain, starting with the certificate itself
+	CertificatePEM string    `json:"-"`
+	PrivateKeyPEM  string    `json:"-"`
+	ExpiresAt      time.Time `json:"expires_at" gorm:"type:timestamptz"`
+}
+
+func (c *Certificate) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("Id", c.ID.String())
+	enc.AddString("Name", c.Name)
+	enc.AddString("ExpiresAt", c.ExpiresAt.String())
+	return nil
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap/zapcore"
+)
+
+// Domain is a hostname reserved for an organization, only the organization's agents can serve tunnels for it.
+// A custom domain is pending until the organization proves it owns it, several organizations can have a
+// pending reservation of the same one but only one can verify it.
+type Domain struct {
+	Base
+	// FK id of the organization that the domain is reserved for
+	OrganizationId uuid.UUID `json:"organization_id" gorm:"type:uuid;index;uniqueIndex:idx_domains_organization_name"`
+	// Name is a subdomain of the public host, i.e "acme", or a custom domain, i.e "app.acme.com"
+	Name string `json:"name" gorm:"uniqueIndex:idx_domains_organization_name;uniqueIndex:idx_domains_verified_name,where:verified_at IS NOT NULL" example:"acme"`
+	// FK id of the user that reserved the domain
+	CreatedBy uuid.UUID `json:"created_by" gorm:"type:uuid"`
+	// VerificationToken proves the organization owns a custom domain once it's published in a TXT record, see
+	// handlers.DomainVerificationPrefix
+	VerificationToken string `json:"verification_token,omitempty" example:"yuka-verification=Xk3bQ2..."`
+	// VerifiedAt is when the reservation took effect, subdomains of the public host are verified when they're reserved
+	VerifiedAt *time.Time `json:"verified_at,omitempty" gorm:"type:timestamptz"`
+}
+
+// IsVerified returns whether the reservation has taken effect
+func (c *Domain) IsVerified() bool {
+	return c.VerifiedAt != nil
+}
+
+func (c *Domain) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("Id", c.ID.String())
+	enc.AddString("OrganizationId", c.OrganizationId.String())
+	enc.AddString("Name", c.Name)
+	enc.AddString("CreatedBy", c.CreatedBy.String())
+	enc.AddBool("Verified", c.IsVerified())
+	return nil
+}
@@ -92,6 +92,19 @@ func NewNotFoundError(resource string) NotFoundError {
 	}
 }
 
+// UnauthorizedError is returned in the body of an HTTP 401
+type UnauthorizedError struct {
+	BaseError
+}
+
+func NewUnauthorizedError() UnauthorizedError {
+	return UnauthorizedError{
+		BaseError: BaseError{
+			Error: "missing or invalid api token",
+		},
+	}
+}
+
 // NotAllowedError is returned in the body of an HTTP 403
 type NotAllowedError struct {
 	BaseError
@@ -106,3 +119,18 @@ func NewNotAllowedError(reason string) NotAllowedError {
 		},
 	}
 }
+
+// BadGatewayError is returned in the body of an HTTP 502
+type BadGatewayError struct {
+	BaseError
+	Reason string `json:"reason,omitempty"`
+}
+
+func NewBadGatewayError(reason string) BadGatewayError {
+	return BadGatewayError{
+		Reason: reason,
+		BaseError: BaseError{
+			Error: "bad gateway",
+		},
+	}
+}
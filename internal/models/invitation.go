@@ -8,15 +8,25 @@ import (
 	"gorm.io/gorm"
 )
 
+// Invitation lets whoever holds its token join an organization once. Only the hash of the token is stored,
+// the token itself is shown once when the invitation is created.
 type Invitation struct {
 	ID uuid.UUID `gorm:"type:uuid;primary_key;" json:"id" example:"aa22666c-0f57-45cb-a449-16efecc04f2e"`
 	// FK id of the organization that the invitation is for
-	// OrganizationId uuid.UUID `json:"organization_id" gorm:"type:uuid"`
+	OrganizationId uuid.UUID `json:"organization_id" gorm:"type:uuid;index"`
 	// FK id of the user that created the invitation
 	CreatedBy uuid.UUID `json:"created_by" gorm:"type:uuid"`
-	Token     string    `json:"token"`
-	CreatedAt time.Time `json:"created_at" gorm:"type:timestamptz;default:now()"`
-	ExpiresAt time.Time `json:"expires_at" gorm:"type:timestamptz;"`
+	// Email is the address the invitation was sent to
+	Email string `json:"email"`
+	// Role is the role in the organization given to the user that accepts the invitation
+	Role      OrganizationRole `json:"role" swaggertype:"string"`
+	TokenHash string           `json:"-" gorm:"uniqueIndex"`
+	CreatedAt time.Time        `json:"created_at" gorm:"type:timestamptz;default:now()"`
+	ExpiresAt time.Time        `json:"expires_at" gorm:"type:timestamptz;"`
+	// AcceptedAt is set once the invitation has been used
+	AcceptedAt *time.Time `json:"accepted_at" gorm:"type:timestamptz;default:null"`
+	// FK id of the user that accepted the invitation
+	AcceptedBy *uuid.UUID `json:"accepted_by" gorm:"type:uuid;default:null"`
 }
 
 // BeforeCreate populates the ID (if not set)
@@ -27,10 +37,17 @@ func (base *Invitation) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// IsExpired checks if the invitation's expiry has passed
+func (c *Invitation) IsExpired() bool {
+	return time.Now().After(c.ExpiresAt)
+}
+
 func (c *Invitation) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("Id", c.ID.String())
+	enc.AddString("OrganizationId", c.OrganizationId.String())
 	enc.AddString("CreatedBy", c.CreatedBy.String())
-	enc.AddString("Token", c.Token)
+	enc.AddString("Email", c.Email)
+	enc.AddString("Role", string(c.Role))
 	enc.AddString("CreatedAt", c.CreatedAt.String())
 	enc.AddString("ExpiresAt", c.ExpiresAt.String())
 	return nil
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// TunnelSession is a tunnel an agent is serving, it's deleted once the agent goes away
+type TunnelSession struct {
+	Base
+	// FK id of the user that the agent authenticated as
+	UserId string `json:"user_id" gorm:"type:uuid;index;default:null"`
+	// FK id of the organization that the agent is acting for
+	OrganizationId string `json:"organization_id" gorm:"type:uuid;index;default:null"`
+	AgentVersion   string `json:"agent_version" example:"v0.1.0"`
+	AgentAddress   string `json:"agent_address" example:"203.0.113.7:53412"`
+	// ServerInstance is the server the agent is connected to
+	ServerInstance string `json:"server_instance" gorm:"index"`
+	// Protocol is one of http, tcp, udp or tls
+	Protocol  string    `json:"protocol" example:"http"`
+	Hostname  string    `json:"hostname" example:"foo"`
+	PublicURL string    `json:"public_url" example:"http://foo.yuka.dev"`
+	StartedAt time.Time `json:"started_at" gorm:"type:timestamptz"`
+}
+
+func (c *TunnelSession) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("Id", c.ID.String())
+	enc.AddString("UserId", c.UserId)
+	enc.AddString("OrganizationId", c.OrganizationId)
+	enc.AddString("ServerInstance", c.ServerInstance)
+	enc.AddString("Protocol", c.Protocol)
+	enc.AddString("Hostname", c.Hostname)
+	enc.AddString("PublicURL", c.PublicURL)
+	return nil
+}
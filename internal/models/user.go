@@ -4,10 +4,10 @@ import "go.uber.org/zap/zapcore"
 
 type User struct {
 	Base
-	AuthID                string `json:"auth_id"`
-	CurrentOrganizationId string `json:"current_organization_id" gorm:"type:uuid;default:null"`
-	// Organizations         []Organization `json:"organizations" gorm:"many2many:user_organizations;"`
-	Username string `json:"username"`
+	AuthID                string         `json:"auth_id"`
+	CurrentOrganizationId string         `json:"current_organization_id" gorm:"type:uuid;default:null"`
+	Organizations         []Organization `json:"organizations,omitempty" gorm:"many2many:user_organizations;"`
+	Username              string         `json:"username"`
 	// TODO: This should be in a separate table but for now we'll just store it here
 	DeviceToken string `json:"device_token"`
 }
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap/zapcore"
+)
+
+// OrganizationRole is what a member is allowed to do in an organization
+type OrganizationRole string
+
+const (
+	// OrganizationRoleOwner can do anything, including deleting the organization
+	OrganizationRoleOwner OrganizationRole = "owner"
+	// OrganizationRoleAdmin can update the organization and manage its members
+	OrganizationRoleAdmin OrganizationRole = "admin"
+	// OrganizationRoleMember can see the organization and serve its tunnels
+	OrganizationRoleMember OrganizationRole = "member"
+)
+
+// Includes checks if the role is allowed to do everything other is
+func (r OrganizationRole) Includes(other OrganizationRole) bool {
+	return r.rank() >= other.rank()
+}
+
+func (r OrganizationRole) rank() int {
+	switch r {
+	case OrganizationRoleOwner:
+		return 3
+	case OrganizationRoleAdmin:
+		return 2
+	case OrganizationRoleMember:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// UserOrganization is the join table of the many2many relation between users and their organizations
+type UserOrganization struct {
+	UserID         uuid.UUID        `json:"user_id" gorm:"type:uuid;primaryKey"`
+	OrganizationID uuid.UUID        `json:"organization_id" gorm:"type:uuid;primaryKey"`
+	Role           OrganizationRole `json:"role" gorm:"not null;default:member"`
+	CreatedAt      time.Time        `json:"created_at" gorm:"type:timestamptz;default:now()"`
+}
+
+func (c *UserOrganization) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("UserId", c.UserID.String())
+	enc.AddString("OrganizationId", c.OrganizationID.String())
+	enc.AddString("Role", string(c.Role))
+	return nil
+}
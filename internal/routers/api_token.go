@@ -0,0 +1,122 @@
+package routers
+
+import (
+	"errors"
+	"net/http"
+
+	"yuka/internal/handlers"
+	"yuka/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// createApiToken creates an ApiToken for a User
+// @Summary      Create API Token
+// @Id  		 createApiToken
+// @Tags         ApiTokens
+// @Description  Creates an API token that agents use to authenticate with the tunnel server. The token is only returned in this response.
+// @Security     ApiToken
+// @Param        id    path      string          true  "User ID"
+// @Accept	     json
+// @Produce      json
+// @Param		 create body handlers.CreateApiTokenInput true "API Token Create"
+// @Success      200  {object}  handlers.CreateApiTokenResponse
+// @Failure      400  {object}  models.ValidationError
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
+// @Failure      404  {object}  models.NotFoundError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/users/{id}/tokens [post]
+func createApiToken(handler handlers.ApiTokenHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, err := uuid.Parse(c.Param("id")); err != nil {
+			c.JSON(http.StatusBadRequest, models.NewBadPathParameterError("id"))
+			return
+		}
+		var input handlers.CreateApiTokenInput
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		apiToken, err := handler.CreateApiToken(c.Param("id"), input)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				c.JSON(http.StatusNotFound, models.NewNotFoundError("user"))
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, apiToken)
+	}
+}
+
+// getApiTokens gets the ApiTokens for a User
+// @Summary      Get API Tokens for specified user
+// @Id  		 getApiTokens
+// @Tags         ApiTokens
+// @Description  Gets the API tokens of a user, the tokens themselves are never returned
+// @Security     ApiToken
+// @Param        id    path      string          true  "User ID"
+// @Accept	     json
+// @Produce      json
+// @Success      200  {array}   models.ApiToken
+// @Failure      400  {object}  models.ValidationError
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/users/{id}/tokens [get]
+func getApiTokens(handler handlers.ApiTokenHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, err := uuid.Parse(c.Param("id")); err != nil {
+			c.JSON(http.StatusBadRequest, models.NewBadPathParameterError("id"))
+			return
+		}
+		apiTokens, err := handler.FindApiTokens(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, apiTokens)
+	}
+}
+
+// deleteApiToken deletes an ApiToken
+// @Summary      Delete API Token
+// @Id  		 deleteApiToken
+// @Tags         ApiTokens
+// @Description  Revokes an API token so it can no longer be used by agents
+// @Security     ApiToken
+// @Param        id       path      string          true  "User ID"
+// @Param        tokenId  path      string          true  "API Token ID"
+// @Accept	     json
+// @Produce      json
+// @Success      200
+// @Failure      400  {object}  models.ValidationError
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
+// @Failure      404  {object}  models.NotFoundError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/users/{id}/tokens/{tokenId} [delete]
+func deleteApiToken(handler handlers.ApiTokenHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for _, param := range []string{"id", "tokenId"} {
+			if _, err := uuid.Parse(c.Param(param)); err != nil {
+				c.JSON(http.StatusBadRequest, models.NewBadPathParameterError(param))
+				return
+			}
+		}
+		if err := handler.DeleteApiToken(c.Param("id"), c.Param("tokenId")); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				c.JSON(http.StatusNotFound, models.NewNotFoundError("token"))
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"data": "ok"})
+	}
+}
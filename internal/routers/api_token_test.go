@@ -0,0 +1,56 @@
+package routers
+
+import (
+	"net/http"
+	"testing"
+	"yuka/internal/handlers"
+	"yuka/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignupReturnsFirstApiToken(t *testing.T) {
+	router, _ := newTestApiRouter(t)
+	userId, token := createTestUser(t, router, "alice")
+
+	var apiTokens []models.ApiToken
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodGet, "/v1/users/"+userId+"/tokens", token, nil, &apiTokens))
+	require.Len(t, apiTokens, 1)
+	assert.Equal(t, "initial", apiTokens[0].Name)
+	// Signing up again with the same auth id doesn't hand out a token for the existing user
+	assert.Equal(t, http.StatusConflict, serveApi(t, router, http.MethodPost, "/v1/users", "",
+		handlers.CreateUserInput{AuthID: "alice", Username: "mallory", DeviceToken: "mallory"}, nil))
+}
+
+func TestApiTokenRoutesRequireAuthentication(t *testing.T) {
+	router, _ := newTestApiRouter(t)
+	userId, _ := createTestUser(t, router, "alice")
+	input := handlers.CreateApiTokenInput{Name: "laptop"}
+
+	assert.Equal(t, http.StatusUnauthorized, serveApi(t, router, http.MethodPost, "/v1/users/"+userId+"/tokens", "", input, nil))
+	assert.Equal(t, http.StatusUnauthorized, serveApi(t, router, http.MethodPost, "/v1/users/"+userId+"/tokens", "yuka_invalid", input, nil))
+	assert.Equal(t, http.StatusUnauthorized, serveApi(t, router, http.MethodGet, "/v1/users/"+userId+"/tokens", "", nil, nil))
+	assert.Equal(t, http.StatusUnauthorized, serveApi(t, router, http.MethodDelete, "/v1/users/"+userId+"/tokens/"+userId, "", nil, nil))
+}
+
+func TestApiTokenRoutesOnlyManageOwnTokens(t *testing.T) {
+	router, _ := newTestApiRouter(t)
+	aliceId, aliceToken := createTestUser(t, router, "alice")
+	bobId, bobToken := createTestUser(t, router, "bob")
+
+	// Nobody can mint, list or revoke the tokens of another user
+	assert.Equal(t, http.StatusForbidden, serveApi(t, router, http.MethodPost, "/v1/users/"+aliceId+"/tokens", bobToken, handlers.CreateApiTokenInput{Name: "stolen"}, nil))
+	var aliceTokens []models.ApiToken
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodGet, "/v1/users/"+aliceId+"/tokens", aliceToken, nil, &aliceTokens))
+	require.Len(t, aliceTokens, 1)
+	assert.Equal(t, http.StatusForbidden, serveApi(t, router, http.MethodGet, "/v1/users/"+aliceId+"/tokens", bobToken, nil, nil))
+	assert.Equal(t, http.StatusForbidden, serveApi(t, router, http.MethodDelete, "/v1/users/"+aliceId+"/tokens/"+aliceTokens[0].ID.String(), bobToken, nil, nil))
+
+	var created handlers.CreateApiTokenResponse
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodPost, "/v1/users/"+bobId+"/tokens", bobToken, handlers.CreateApiTokenInput{Name: "laptop"}, &created))
+	assert.Equal(t, "laptop", created.Name)
+	assert.Equal(t, http.StatusOK, serveApi(t, router, http.MethodGet, "/v1/users/"+bobId+"/tokens", created.Token, nil, nil))
+	assert.Equal(t, http.StatusOK, serveApi(t, router, http.MethodDelete, "/v1/users/"+aliceId+"/tokens/"+aliceTokens[0].ID.String(), aliceToken, nil, nil))
+	assert.Equal(t, http.StatusUnauthorized, serveApi(t, router, http.MethodGet, "/v1/users/"+aliceId+"/tokens", aliceToken, nil, nil))
+}
@@ -0,0 +1,47 @@
+package routers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"yuka/internal/handlers"
+	"yuka/internal/models"
+	"yuka/pkg/streaming_connection"
+
+	"github.com/gin-gonic/gin"
+)
+
+// userIdKey is the context key authenticateUser stores the id of the authenticated user under
+const userIdKey = "userId"
+
+// authenticateUser authenticates requests with an API token in their Authorization header, the same tokens
+// agents connect with, storing the id of the token's user in the context
+func authenticateUser(handler handlers.ApiTokenHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, _ := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		identity, err := handler.Authenticate(token)
+		if err != nil {
+			if errors.Is(err, streaming_connection.ErrInvalidAuthToken) {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, models.NewUnauthorizedError())
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.Set(userIdKey, identity.UserID)
+		c.Next()
+	}
+}
+
+// requireSameUser only lets requests through when the user in the :id path parameter is the authenticated
+// user, it must run after authenticateUser
+func requireSameUser() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Param("id") != c.GetString(userIdKey) {
+			c.AbortWithStatusJSON(http.StatusForbidden, models.NewNotAllowedError("users can only manage themselves"))
+			return
+		}
+		c.Next()
+	}
+}
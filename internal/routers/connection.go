@@ -0,0 +1,32 @@
+package routers
+
+import (
+	"net/http"
+
+	"yuka/internal/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getConnections gets the agent connections of the authenticated user
+// @Summary      Get Connections
+// @Id  		 getConnections
+// @Tags         Connections
+// @Description  Gets the connections of the authenticated user's agents and of the agents of the organizations they're a member of, along with the latency measured by their heartbeats
+// @Security     ApiToken
+// @Accept	     json
+// @Produce      json
+// @Success      200  {array}   handlers.ConnectionInfo
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/connections [get]
+func getConnections(handler handlers.ConnectionHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		connections, err := handler.FindConnections(c.GetString(userIdKey))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, connections)
+	}
+}
@@ -0,0 +1,54 @@
+package routers
+
+import (
+	"net"
+	"net/http"
+	"testing"
+	"yuka/internal/handlers"
+	"yuka/internal/models"
+	"yuka/pkg/streaming_connection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// addTestConnection registers an agent connection for hostname claimed by owner
+func addTestConnection(t *testing.T, pool *streaming_connection.StreamingConnectionPool, hostname string, owner string) {
+	serverConn, agentConn := net.Pipe()
+	server := streaming_connection.NewMuxSession(zap.NewNop(), serverConn, false, nil)
+	agent := streaming_connection.NewMuxSession(zap.NewNop(), agentConn, true, nil)
+	t.Cleanup(func() {
+		agent.Close()
+		server.Close()
+	})
+	require.NoError(t, pool.AddConnection(hostname, owner, server))
+}
+
+func TestConnectionRoutesOnlyListOwnConnections(t *testing.T) {
+	pool := streaming_connection.NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	router, _ := newTestApiRouterWithPool(t, pool)
+	aliceId, aliceToken := createTestUser(t, router, "alice")
+	bobId, bobToken := createTestUser(t, router, "bob")
+
+	var organization models.Organization
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodPost, "/v1/organizations", aliceToken,
+		handlers.CreateOrganizationInput{Name: "acme"}, &organization))
+	addTestConnection(t, pool, "alice", (&streaming_connection.AgentIdentity{UserID: aliceId}).Owner())
+	addTestConnection(t, pool, "bob", (&streaming_connection.AgentIdentity{UserID: bobId}).Owner())
+	addTestConnection(t, pool, "acme", streaming_connection.OrganizationOwner(organization.ID.String()))
+
+	assert.Equal(t, http.StatusUnauthorized, serveApi(t, router, http.MethodGet, "/v1/connections", "", nil, nil))
+
+	hostnames := func(token string) []string {
+		var connections []handlers.ConnectionInfo
+		require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodGet, "/v1/connections", token, nil, &connections))
+		hostnames := []string{}
+		for _, connection := range connections {
+			hostnames = append(hostnames, connection.Hostname)
+		}
+		return hostnames
+	}
+	assert.Equal(t, []string{"acme", "alice"}, hostnames(aliceToken))
+	assert.Equal(t, []string{"bob"}, hostnames(bobToken))
+}
@@ -0,0 +1,157 @@
+package routers
+
+import (
+	"errors"
+	"net/http"
+
+	"yuka/internal/handlers"
+	"yuka/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// reserveDomain reserves a Domain for an Organization
+// @Summary      Reserve Domain
+// @Id  		 reserveDomain
+// @Tags         Domains
+// @Description  Reserves a subdomain of the public host or a custom domain for an organization so only its agents can serve tunnels for it, the authenticated user must be one of its owners or admins. Custom domains are pending until they're verified.
+// @Security     ApiToken
+// @Accept	     json
+// @Produce      json
+// @Param		 create body handlers.ReserveDomainInput true "Domain Reserve"
+// @Success      200  {object}  models.Domain
+// @Failure      400  {object}  models.ValidationError
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
+// @Failure      404  {object}  models.NotFoundError
+// @Failure      409  {object}  models.ConflictsError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/domains [post]
+func reserveDomain(handler handlers.DomainHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var input handlers.ReserveDomainInput
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		domain, err := handler.ReserveDomain(c.GetString(userIdKey), input)
+		if err != nil {
+			if errors.Is(err, handlers.ErrDomainReserved) {
+				c.JSON(http.StatusConflict, models.NewConflictsError(input.Name))
+				return
+			}
+			if errors.Is(err, handlers.ErrDomainInvalid) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			writeOrganizationError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, domain)
+	}
+}
+
+// verifyDomain verifies a pending custom Domain
+// @Summary      Verify Domain
+// @Id  		 verifyDomain
+// @Tags         Domains
+// @Description  Verifies the organization owns a custom domain by looking for the domain's verification token in the TXT record at _yuka-challenge.<domain>, after which only its agents can serve tunnels for it. The authenticated user must be one of the owners or admins of its organization.
+// @Security     ApiToken
+// @Param        id    path      string          true  "Domain ID"
+// @Accept	     json
+// @Produce      json
+// @Success      200  {object}  models.Domain
+// @Failure      400  {object}  models.ValidationError
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
+// @Failure      404  {object}  models.NotFoundError
+// @Failure      409  {object}  models.ConflictsError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/domains/{id}/verify [post]
+func verifyDomain(handler handlers.DomainHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, err := uuid.Parse(c.Param("id")); err != nil {
+			c.JSON(http.StatusBadRequest, models.NewBadPathParameterError("id"))
+			return
+		}
+		domain, err := handler.VerifyDomain(c.GetString(userIdKey), c.Param("id"))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				c.JSON(http.StatusNotFound, models.NewNotFoundError("domain"))
+				return
+			}
+			if errors.Is(err, handlers.ErrDomainNotVerified) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			if errors.Is(err, handlers.ErrDomainReserved) {
+				c.JSON(http.StatusConflict, models.NewConflictsError(c.Param("id")))
+				return
+			}
+			writeOrganizationError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, domain)
+	}
+}
+
+// getDomains gets the Domains reserved for the Organizations of the authenticated user
+// @Summary      Get Domains
+// @Id  		 getDomains
+// @Tags         Domains
+// @Description  Gets the domains reserved for the organizations the authenticated user is a member of
+// @Security     ApiToken
+// @Accept	     json
+// @Produce      json
+// @Success      200  {array}   models.Domain
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/domains [get]
+func getDomains(handler handlers.DomainHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		domains, err := handler.FindDomains(c.GetString(userIdKey))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, domains)
+	}
+}
+
+// releaseDomain releases a reserved Domain
+// @Summary      Release Domain
+// @Id  		 releaseDomain
+// @Tags         Domains
+// @Description  Deletes the reservation of a domain so anyone can serve tunnels for it, the authenticated user must be one of the owners or admins of its organization
+// @Security     ApiToken
+// @Param        id    path      string          true  "Domain ID"
+// @Accept	     json
+// @Produce      json
+// @Success      200
+// @Failure      400  {object}  models.ValidationError
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
+// @Failure      404  {object}  models.NotFoundError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/domains/{id} [delete]
+func releaseDomain(handler handlers.DomainHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, err := uuid.Parse(c.Param("id")); err != nil {
+			c.JSON(http.StatusBadRequest, models.NewBadPathParameterError("id"))
+			return
+		}
+		if err := handler.ReleaseDomain(c.GetString(userIdKey), c.Param("id")); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				c.JSON(http.StatusNotFound, models.NewNotFoundError("domain"))
+				return
+			}
+			writeOrganizationError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"data": "ok"})
+	}
+}
@@ -0,0 +1,167 @@
+package routers
+
+import (
+	"errors"
+	"net/http"
+
+	"yuka/internal/handlers"
+	"yuka/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// createInvitation creates an Invitation to an Organization
+// @Summary      Create Invitation
+// @Id  		 createInvitation
+// @Tags         Invitations
+// @Description  Creates an invitation to an organization, the authenticated user must be one of its owners or admins and only owners can invite other owners. The token is only returned in this response.
+// @Security     ApiToken
+// @Param        id    path      string          true  "Organization ID"
+// @Accept	     json
+// @Produce      json
+// @Param		 create body handlers.CreateInvitationInput true "Invitation Create"
+// @Success      200  {object}  handlers.CreateInvitationResponse
+// @Failure      400  {object}  models.ValidationError
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
+// @Failure      404  {object}  models.NotFoundError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/organizations/{id}/invitations [post]
+func createInvitation(handler handlers.InvitationHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, err := uuid.Parse(c.Param("id")); err != nil {
+			c.JSON(http.StatusBadRequest, models.NewBadPathParameterError("id"))
+			return
+		}
+		var input handlers.CreateInvitationInput
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		invitation, err := handler.CreateInvitation(c.GetString(userIdKey), c.Param("id"), input)
+		if err != nil {
+			if errors.Is(err, handlers.ErrInvitationExpiryInPast) {
+				c.JSON(http.StatusBadRequest, models.NewFieldValidationError("expires_at", err.Error()))
+				return
+			}
+			writeOrganizationError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, invitation)
+	}
+}
+
+// getInvitations gets the pending Invitations to an Organization
+// @Summary      Get Invitations
+// @Id  		 getInvitations
+// @Tags         Invitations
+// @Description  Gets the invitations to an organization that haven't been accepted or expired, the authenticated user must be one of its owners or admins
+// @Security     ApiToken
+// @Param        id    path      string          true  "Organization ID"
+// @Accept	     json
+// @Produce      json
+// @Success      200  {array}   models.Invitation
+// @Failure      400  {object}  models.ValidationError
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
+// @Failure      404  {object}  models.NotFoundError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/organizations/{id}/invitations [get]
+func getInvitations(handler handlers.InvitationHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, err := uuid.Parse(c.Param("id")); err != nil {
+			c.JSON(http.StatusBadRequest, models.NewBadPathParameterError("id"))
+			return
+		}
+		invitations, err := handler.FindPendingInvitations(c.GetString(userIdKey), c.Param("id"))
+		if err != nil {
+			writeOrganizationError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, invitations)
+	}
+}
+
+// revokeInvitation revokes a pending Invitation to an Organization
+// @Summary      Revoke Invitation
+// @Id  		 revokeInvitation
+// @Tags         Invitations
+// @Description  Revokes an invitation to an organization that hasn't been accepted, the authenticated user must be one of its owners or admins
+// @Security     ApiToken
+// @Param        id            path      string          true  "Organization ID"
+// @Param        invitationId  path      string          true  "Invitation ID"
+// @Accept	     json
+// @Produce      json
+// @Success      200
+// @Failure      400  {object}  models.ValidationError
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
+// @Failure      404  {object}  models.NotFoundError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/organizations/{id}/invitations/{invitationId} [delete]
+func revokeInvitation(handler handlers.InvitationHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for _, param := range []string{"id", "invitationId"} {
+			if _, err := uuid.Parse(c.Param(param)); err != nil {
+				c.JSON(http.StatusBadRequest, models.NewBadPathParameterError(param))
+				return
+			}
+		}
+		err := handler.RevokeInvitation(c.GetString(userIdKey), c.Param("id"), c.Param("invitationId"))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				c.JSON(http.StatusNotFound, models.NewNotFoundError("invitation"))
+				return
+			}
+			writeOrganizationError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"data": "ok"})
+	}
+}
+
+// acceptInvitation accepts an Invitation, joining its Organization
+// @Summary      Accept Invitation
+// @Id  		 acceptInvitation
+// @Tags         Invitations
+// @Description  Adds the authenticated user to the organization of the invitation with the token. Invitations can only be accepted once and not after they expire.
+// @Security     ApiToken
+// @Accept	     json
+// @Produce      json
+// @Param		 accept body handlers.AcceptInvitationInput true "Invitation Accept"
+// @Success      200  {object}  models.Organization
+// @Failure      400  {object}  models.ValidationError
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
+// @Failure      404  {object}  models.NotFoundError
+// @Failure      409  {object}  models.ConflictsError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/invitations/accept [post]
+func acceptInvitation(handler handlers.InvitationHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var input handlers.AcceptInvitationInput
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		organization, err := handler.AcceptInvitation(c.GetString(userIdKey), input)
+		if err != nil {
+			switch {
+			case errors.Is(err, handlers.ErrInvitationNotFound):
+				c.JSON(http.StatusNotFound, models.NewNotFoundError("invitation"))
+			case errors.Is(err, handlers.ErrInvitationExpired), errors.Is(err, handlers.ErrInvitationAccepted):
+				c.JSON(http.StatusForbidden, models.NewNotAllowedError(err.Error()))
+			case errors.Is(err, handlers.ErrAlreadyOrganizationMember):
+				c.JSON(http.StatusConflict, models.NewConflictsError(c.GetString(userIdKey)))
+			default:
+				writeOrganizationError(c, err)
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, organization)
+	}
+}
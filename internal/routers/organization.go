@@ -0,0 +1,341 @@
+package routers
+
+import (
+	"errors"
+	"net/http"
+
+	"yuka/internal/handlers"
+	"yuka/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// createOrganization creates an Organization owned by the authenticated user
+// @Summary      Create Organization
+// @Id  		 createOrganization
+// @Tags         Organizations
+// @Description  Creates an organization owned by the authenticated user, it becomes their current organization if they don't have one
+// @Security     ApiToken
+// @Accept	     json
+// @Produce      json
+// @Param		 create body handlers.CreateOrganizationInput true "Organization Create"
+// @Success      200  {object}  models.Organization
+// @Failure      400  {object}  models.ValidationError
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/organizations [post]
+func createOrganization(handler handlers.OrganizationHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var input handlers.CreateOrganizationInput
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		organization, err := handler.CreateOrganization(c.GetString(userIdKey), input)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, organization)
+	}
+}
+
+// getOrganizations gets the Organizations of the authenticated user
+// @Summary      Get Organizations
+// @Id  		 getOrganizations
+// @Tags         Organizations
+// @Description  Gets the organizations the authenticated user is a member of
+// @Security     ApiToken
+// @Accept	     json
+// @Produce      json
+// @Success      200  {array}   models.Organization
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/organizations [get]
+func getOrganizations(handler handlers.OrganizationHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		organizations, err := handler.FindOrganizations(c.GetString(userIdKey))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, organizations)
+	}
+}
+
+// getOrganization gets an Organization for the specified id
+// @Summary      Get Organization for specified id
+// @Id  		 getOrganization
+// @Tags         Organizations
+// @Description  Gets an organization the authenticated user is a member of
+// @Security     ApiToken
+// @Param        id    path      string          true  "Organization ID"
+// @Accept	     json
+// @Produce      json
+// @Success      200  {object}  models.Organization
+// @Failure      400  {object}  models.ValidationError
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
+// @Failure      404  {object}  models.NotFoundError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/organizations/{id} [get]
+func getOrganization(handler handlers.OrganizationHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, err := uuid.Parse(c.Param("id")); err != nil {
+			c.JSON(http.StatusBadRequest, models.NewBadPathParameterError("id"))
+			return
+		}
+		organization, err := handler.FindOrganization(c.GetString(userIdKey), c.Param("id"))
+		if err != nil {
+			writeOrganizationError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, organization)
+	}
+}
+
+// updateOrganization updates an Organization
+// @Summary      Update Organization
+// @Id  		 updateOrganization
+// @Tags         Organizations
+// @Description  Updates an organization, the authenticated user must be one of its owners or admins
+// @Security     ApiToken
+// @Param        id    path      string          true  "Organization ID"
+// @Accept	     json
+// @Produce      json
+// @Param		 update body handlers.UpdateOrganizationInput true "Organization Update"
+// @Success      200  {object}  models.Organization
+// @Failure      400  {object}  models.ValidationError
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
+// @Failure      404  {object}  models.NotFoundError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/organizations/{id} [put]
+func updateOrganization(handler handlers.OrganizationHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, err := uuid.Parse(c.Param("id")); err != nil {
+			c.JSON(http.StatusBadRequest, models.NewBadPathParameterError("id"))
+			return
+		}
+		var input handlers.UpdateOrganizationInput
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		organization, err := handler.UpdateOrganization(c.GetString(userIdKey), c.Param("id"), input)
+		if err != nil {
+			writeOrganizationError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, organization)
+	}
+}
+
+// deleteOrganization deletes an Organization
+// @Summary      Delete Organization
+// @Id  		 deleteOrganization
+// @Tags         Organizations
+// @Description  Deletes an organization along with its memberships, the authenticated user must be one of its owners
+// @Security     ApiToken
+// @Param        id    path      string          true  "Organization ID"
+// @Accept	     json
+// @Produce      json
+// @Success      200
+// @Failure      400  {object}  models.ValidationError
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
+// @Failure      404  {object}  models.NotFoundError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/organizations/{id} [delete]
+func deleteOrganization(handler handlers.OrganizationHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, err := uuid.Parse(c.Param("id")); err != nil {
+			c.JSON(http.StatusBadRequest, models.NewBadPathParameterError("id"))
+			return
+		}
+		if err := handler.DeleteOrganization(c.GetString(userIdKey), c.Param("id")); err != nil {
+			writeOrganizationError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"data": "ok"})
+	}
+}
+
+// getOrganizationMembers gets the members of an Organization
+// @Summary      Get Organization Members
+// @Id  		 getOrganizationMembers
+// @Tags         Organizations
+// @Description  Gets the members of an organization along with their roles, the authenticated user must be a member
+// @Security     ApiToken
+// @Param        id    path      string          true  "Organization ID"
+// @Accept	     json
+// @Produce      json
+// @Success      200  {array}   handlers.OrganizationMember
+// @Failure      400  {object}  models.ValidationError
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
+// @Failure      404  {object}  models.NotFoundError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/organizations/{id}/members [get]
+func getOrganizationMembers(handler handlers.OrganizationHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, err := uuid.Parse(c.Param("id")); err != nil {
+			c.JSON(http.StatusBadRequest, models.NewBadPathParameterError("id"))
+			return
+		}
+		members, err := handler.FindOrganizationMembers(c.GetString(userIdKey), c.Param("id"))
+		if err != nil {
+			writeOrganizationError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, members)
+	}
+}
+
+// addOrganizationMember adds a User to an Organization
+// @Summary      Add Organization Member
+// @Id  		 addOrganizationMember
+// @Tags         Organizations
+// @Description  Adds a user to an organization, the authenticated user must be one of its owners or admins and only owners can add other owners
+// @Security     ApiToken
+// @Param        id    path      string          true  "Organization ID"
+// @Accept	     json
+// @Produce      json
+// @Param		 create body handlers.AddOrganizationMemberInput true "Organization Member Add"
+// @Success      200  {object}  handlers.OrganizationMember
+// @Failure      400  {object}  models.ValidationError
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
+// @Failure      404  {object}  models.NotFoundError
+// @Failure      409  {object}  models.ConflictsError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/organizations/{id}/members [post]
+func addOrganizationMember(handler handlers.OrganizationHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, err := uuid.Parse(c.Param("id")); err != nil {
+			c.JSON(http.StatusBadRequest, models.NewBadPathParameterError("id"))
+			return
+		}
+		var input handlers.AddOrganizationMemberInput
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		member, err := handler.AddOrganizationMember(c.GetString(userIdKey), c.Param("id"), input)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				c.JSON(http.StatusNotFound, models.NewNotFoundError("user"))
+				return
+			}
+			if errors.Is(err, handlers.ErrAlreadyOrganizationMember) {
+				c.JSON(http.StatusConflict, models.NewConflictsError(input.UserId))
+				return
+			}
+			writeOrganizationError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, member)
+	}
+}
+
+// updateOrganizationMember changes the role of a User in an Organization
+// @Summary      Update Organization Member
+// @Id  		 updateOrganizationMember
+// @Tags         Organizations
+// @Description  Changes the role of a member of an organization, the authenticated user must be one of its owners or admins and only owners can promote to or demote from owner. The last owner can't be demoted.
+// @Security     ApiToken
+// @Param        id      path      string          true  "Organization ID"
+// @Param        userId  path      string          true  "User ID"
+// @Accept	     json
+// @Produce      json
+// @Param		 update body handlers.UpdateOrganizationMemberInput true "Organization Member Update"
+// @Success      200  {object}  handlers.OrganizationMember
+// @Failure      400  {object}  models.ValidationError
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
+// @Failure      404  {object}  models.NotFoundError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/organizations/{id}/members/{userId} [put]
+func updateOrganizationMember(handler handlers.OrganizationHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for _, param := range []string{"id", "userId"} {
+			if _, err := uuid.Parse(c.Param(param)); err != nil {
+				c.JSON(http.StatusBadRequest, models.NewBadPathParameterError(param))
+				return
+			}
+		}
+		var input handlers.UpdateOrganizationMemberInput
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		member, err := handler.UpdateOrganizationMember(c.GetString(userIdKey), c.Param("id"), c.Param("userId"), input)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				c.JSON(http.StatusNotFound, models.NewNotFoundError("member"))
+				return
+			}
+			writeOrganizationError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, member)
+	}
+}
+
+// removeOrganizationMember removes a User from an Organization
+// @Summary      Remove Organization Member
+// @Id  		 removeOrganizationMember
+// @Tags         Organizations
+// @Description  Removes a user from an organization. Members can always remove themselves, otherwise the authenticated user must be one of its owners or admins and only owners can remove other owners. The last owner can't be removed.
+// @Security     ApiToken
+// @Param        id      path      string          true  "Organization ID"
+// @Param        userId  path      string          true  "User ID"
+// @Accept	     json
+// @Produce      json
+// @Success      200
+// @Failure      400  {object}  models.ValidationError
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
+// @Failure      404  {object}  models.NotFoundError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/organizations/{id}/members/{userId} [delete]
+func removeOrganizationMember(handler handlers.OrganizationHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for _, param := range []string{"id", "userId"} {
+			if _, err := uuid.Parse(c.Param(param)); err != nil {
+				c.JSON(http.StatusBadRequest, models.NewBadPathParameterError(param))
+				return
+			}
+		}
+		err := handler.RemoveOrganizationMember(c.GetString(userIdKey), c.Param("id"), c.Param("userId"))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				c.JSON(http.StatusNotFound, models.NewNotFoundError("member"))
+				return
+			}
+			writeOrganizationError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"data": "ok"})
+	}
+}
+
+// writeOrganizationError writes the response for an error returned by the OrganizationHandler
+func writeOrganizationError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, handlers.ErrOrganizationNotFound):
+		c.JSON(http.StatusNotFound, models.NewNotFoundError("organization"))
+	case errors.Is(err, handlers.ErrNotOrganizationMember),
+		errors.Is(err, handlers.ErrOrganizationRoleRequired),
+		errors.Is(err, handlers.ErrLastOrganizationOwner):
+		c.JSON(http.StatusForbidden, models.NewNotAllowedError(err.Error()))
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,192 @@
+package routers
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+	"yuka/internal/handlers"
+	"yuka/internal/models"
+	"yuka/pkg/streaming_connection"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestUserRoutesOnlyManageThemselves(t *testing.T) {
+	router, _ := newTestApiRouter(t)
+	aliceId, aliceToken := createTestUser(t, router, "alice")
+	_, bobToken := createTestUser(t, router, "bob")
+	update := handlers.UpdateUserInput{Username: "mallory"}
+
+	assert.Equal(t, http.StatusUnauthorized, serveApi(t, router, http.MethodGet, "/v1/users/"+aliceId, "", nil, nil))
+	assert.Equal(t, http.StatusUnauthorized, serveApi(t, router, http.MethodPut, "/v1/users/"+aliceId, "", update, nil))
+	assert.Equal(t, http.StatusUnauthorized, serveApi(t, router, http.MethodDelete, "/v1/users/"+aliceId, "", nil, nil))
+	assert.Equal(t, http.StatusForbidden, serveApi(t, router, http.MethodGet, "/v1/users/"+aliceId, bobToken, nil, nil))
+	assert.Equal(t, http.StatusForbidden, serveApi(t, router, http.MethodPut, "/v1/users/"+aliceId, bobToken, update, nil))
+	assert.Equal(t, http.StatusForbidden, serveApi(t, router, http.MethodDelete, "/v1/users/"+aliceId, bobToken, nil, nil))
+
+	var user models.User
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodGet, "/v1/users/"+aliceId, aliceToken, nil, &user))
+	assert.Equal(t, "alice", user.Username)
+}
+
+func TestOrganizationRoutesEnforceRoles(t *testing.T) {
+	router, _ := newTestApiRouter(t)
+	ownerId, ownerToken := createTestUser(t, router, "owner")
+	adminId, adminToken := createTestUser(t, router, "admin")
+	memberId, memberToken := createTestUser(t, router, "member")
+	_, outsiderToken := createTestUser(t, router, "outsider")
+
+	var organization models.Organization
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodPost, "/v1/organizations", ownerToken,
+		handlers.CreateOrganizationInput{Name: "acme"}, &organization))
+	path := "/v1/organizations/" + organization.ID.String()
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodPost, path+"/members", ownerToken,
+		handlers.AddOrganizationMemberInput{UserId: adminId, Role: models.OrganizationRoleAdmin}, nil))
+	// Admins can add members but not owners
+	assert.Equal(t, http.StatusForbidden, serveApi(t, router, http.MethodPost, path+"/members", adminToken,
+		handlers.AddOrganizationMemberInput{UserId: memberId, Role: models.OrganizationRoleOwner}, nil))
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodPost, path+"/members", adminToken,
+		handlers.AddOrganizationMemberInput{UserId: memberId}, nil))
+	assert.Equal(t, http.StatusConflict, serveApi(t, router, http.MethodPost, path+"/members", adminToken,
+		handlers.AddOrganizationMemberInput{UserId: memberId}, nil))
+
+	update := handlers.UpdateOrganizationInput{Description: "updated"}
+	assert.Equal(t, http.StatusUnauthorized, serveApi(t, router, http.MethodGet, path, "", nil, nil))
+	assert.Equal(t, http.StatusForbidden, serveApi(t, router, http.MethodGet, path, outsiderToken, nil, nil))
+	assert.Equal(t, http.StatusOK, serveApi(t, router, http.MethodGet, path, memberToken, nil, nil))
+	assert.Equal(t, http.StatusForbidden, serveApi(t, router, http.MethodPut, path, memberToken, update, nil))
+	assert.Equal(t, http.StatusOK, serveApi(t, router, http.MethodPut, path, adminToken, update, nil))
+	assert.Equal(t, http.StatusForbidden, serveApi(t, router, http.MethodDelete, path, adminToken, nil, nil))
+
+	// The only owner can't be demoted, by admins or themselves, nor leave
+	toAdmin := handlers.UpdateOrganizationMemberInput{Role: models.OrganizationRoleAdmin}
+	assert.Equal(t, http.StatusForbidden, serveApi(t, router, http.MethodPut, path+"/members/"+ownerId, adminToken, toAdmin, nil))
+	assert.Equal(t, http.StatusForbidden, serveApi(t, router, http.MethodPut, path+"/members/"+ownerId, ownerToken, toAdmin, nil))
+	assert.Equal(t, http.StatusForbidden, serveApi(t, router, http.MethodDelete, path+"/members/"+ownerId, ownerToken, nil, nil))
+	assert.Equal(t, http.StatusForbidden, serveApi(t, router, http.MethodDelete, path+"/members/"+adminId, memberToken, nil, nil))
+	assert.Equal(t, http.StatusBadRequest, serveApi(t, router, http.MethodPut, path+"/members/"+memberId, adminToken,
+		handlers.UpdateOrganizationMemberInput{Role: "superuser"}, nil))
+
+	var member handlers.OrganizationMember
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodPut, path+"/members/"+memberId, adminToken, toAdmin, &member))
+	assert.Equal(t, models.OrganizationRoleAdmin, member.Role)
+	assert.Equal(t, http.StatusOK, serveApi(t, router, http.MethodDelete, path+"/members/"+memberId, adminToken, nil, nil))
+	assert.Equal(t, http.StatusNotFound, serveApi(t, router, http.MethodDelete, path+"/members/"+memberId, adminToken, nil, nil))
+	assert.Equal(t, http.StatusOK, serveApi(t, router, http.MethodDelete, path, ownerToken, nil, nil))
+	assert.Equal(t, http.StatusNotFound, serveApi(t, router, http.MethodGet, path, ownerToken, nil, nil))
+}
+
+func TestInvitationRoutesAcceptOnce(t *testing.T) {
+	router, db := newTestApiRouter(t)
+	_, ownerToken := createTestUser(t, router, "owner")
+	_, memberToken := createTestUser(t, router, "member")
+
+	var organization models.Organization
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodPost, "/v1/organizations", ownerToken,
+		handlers.CreateOrganizationInput{Name: "acme"}, &organization))
+	path := "/v1/organizations/" + organization.ID.String() + "/invitations"
+	input := handlers.CreateInvitationInput{Email: "joiner@yuka.dev"}
+	assert.Equal(t, http.StatusForbidden, serveApi(t, router, http.MethodPost, path, memberToken, input, nil))
+	past := time.Now().Add(-time.Minute)
+	assert.Equal(t, http.StatusBadRequest, serveApi(t, router, http.MethodPost, path, ownerToken,
+		handlers.CreateInvitationInput{Email: "joiner@yuka.dev", ExpiresAt: &past}, nil))
+
+	var invitation handlers.CreateInvitationResponse
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodPost, path, ownerToken, input, &invitation))
+	accept := handlers.AcceptInvitationInput{Token: invitation.Token}
+	assert.Equal(t, http.StatusUnauthorized, serveApi(t, router, http.MethodPost, "/v1/invitations/accept", "", accept, nil))
+
+	const joiners = 5
+	codes := make([]int, joiners)
+	var wg sync.WaitGroup
+	for i := range codes {
+		_, token := createTestUser(t, router, fmt.Sprintf("joiner-%d", i))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			codes[i] = serveApi(t, router, http.MethodPost, "/v1/invitations/accept", token, accept, nil)
+		}()
+	}
+	wg.Wait()
+	assert.ElementsMatch(t, []int{http.StatusOK, http.StatusForbidden, http.StatusForbidden, http.StatusForbidden, http.StatusForbidden}, codes)
+
+	// Expired invitations can't be accepted either
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodPost, path, ownerToken, input, &invitation))
+	require.NoError(t, db.Model(&models.Invitation{}).Where("id = ?", invitation.ID).Update("expires_at", past).Error)
+	assert.Equal(t, http.StatusForbidden, serveApi(t, router, http.MethodPost, "/v1/invitations/accept", memberToken,
+		handlers.AcceptInvitationInput{Token: invitation.Token}, nil))
+	assert.Equal(t, http.StatusNotFound, serveApi(t, router, http.MethodPost, "/v1/invitations/accept", memberToken,
+		handlers.AcceptInvitationInput{Token: "yukainv_unknown"}, nil))
+}
+
+func TestDomainRoutesConflictAcrossOrganizations(t *testing.T) {
+	router, _ := newTestApiRouter(t)
+	_, aliceToken := createTestUser(t, router, "alice")
+	_, bobToken := createTestUser(t, router, "bob")
+
+	var aliceOrganization, bobOrganization models.Organization
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodPost, "/v1/organizations", aliceToken,
+		handlers.CreateOrganizationInput{Name: "alice"}, &aliceOrganization))
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodPost, "/v1/organizations", bobToken,
+		handlers.CreateOrganizationInput{Name: "bob"}, &bobOrganization))
+
+	var domain models.Domain
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodPost, "/v1/domains", aliceToken,
+		handlers.ReserveDomainInput{OrganizationId: aliceOrganization.ID.String(), Name: "acme"}, &domain))
+	assert.Equal(t, http.StatusConflict, serveApi(t, router, http.MethodPost, "/v1/domains", bobToken,
+		handlers.ReserveDomainInput{OrganizationId: bobOrganization.ID.String(), Name: "acme.yuka.dev"}, nil))
+	assert.Equal(t, http.StatusForbidden, serveApi(t, router, http.MethodPost, "/v1/domains", bobToken,
+		handlers.ReserveDomainInput{OrganizationId: aliceOrganization.ID.String(), Name: "bob"}, nil))
+	assert.Equal(t, http.StatusBadRequest, serveApi(t, router, http.MethodPost, "/v1/domains", bobToken,
+		handlers.ReserveDomainInput{OrganizationId: bobOrganization.ID.String(), Name: "yuka.dev"}, nil))
+	assert.Equal(t, http.StatusForbidden, serveApi(t, router, http.MethodDelete, "/v1/domains/"+domain.ID.String(), bobToken, nil, nil))
+
+	var domains []models.Domain
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodGet, "/v1/domains", bobToken, nil, &domains))
+	assert.Empty(t, domains)
+
+	// Custom domains stay pending until their verification record is published
+	var customDomain models.Domain
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodPost, "/v1/domains", bobToken,
+		handlers.ReserveDomainInput{OrganizationId: bobOrganization.ID.String(), Name: "app.acme.com"}, &customDomain))
+	assert.Nil(t, customDomain.VerifiedAt)
+	assert.NotEmpty(t, customDomain.VerificationToken)
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodPost, "/v1/domains", aliceToken,
+		handlers.ReserveDomainInput{OrganizationId: aliceOrganization.ID.String(), Name: "app.acme.com"}, nil))
+	assert.Equal(t, http.StatusForbidden, serveApi(t, router, http.MethodPost, "/v1/domains/"+customDomain.ID.String()+"/verify", aliceToken, nil, nil))
+	assert.Equal(t, http.StatusBadRequest, serveApi(t, router, http.MethodPost, "/v1/domains/"+customDomain.ID.String()+"/verify", bobToken, nil, nil))
+	assert.Equal(t, http.StatusNotFound, serveApi(t, router, http.MethodPost, "/v1/domains/"+uuid.NewString()+"/verify", bobToken, nil, nil))
+	// Subdomains of the public host don't need verifying
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodPost, "/v1/domains/"+domain.ID.String()+"/verify", aliceToken, nil, &domain))
+	assert.NotNil(t, domain.VerifiedAt)
+}
+
+func TestTunnelRoutesListPersistedSessions(t *testing.T) {
+	router, db := newTestApiRouter(t)
+	aliceId, aliceToken := createTestUser(t, router, "alice")
+	_, bobToken := createTestUser(t, router, "bob")
+
+	// Sessions recorded by another server sharing the database are listed too
+	recorder := handlers.NewTunnelSessionHandler(zap.NewNop(), db, "other")
+	require.NoError(t, recorder.TunnelStarted(&streaming_connection.TunnelSession{
+		Identity:  &streaming_connection.AgentIdentity{UserID: aliceId},
+		Protocol:  "http",
+		Hostname:  "foo",
+		PublicURL: "http://foo.yuka.dev",
+		StartedAt: time.Now(),
+	}))
+
+	assert.Equal(t, http.StatusUnauthorized, serveApi(t, router, http.MethodGet, "/v1/tunnels", "", nil, nil))
+	var sessions []models.TunnelSession
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodGet, "/v1/tunnels", aliceToken, nil, &sessions))
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "http://foo.yuka.dev", sessions[0].PublicURL)
+	assert.Equal(t, "other", sessions[0].ServerInstance)
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodGet, "/v1/tunnels", bobToken, nil, &sessions))
+	assert.Empty(t, sessions)
+}
@@ -2,8 +2,11 @@ package routers
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"net"
 	"net/http"
+	"os"
 	"time"
 
 	"go.uber.org/zap"
@@ -12,6 +15,7 @@ import (
 
 	"yuka/internal/handlers"
 	"yuka/pkg/streaming_connection"
+	"yuka/pkg/tls_helper"
 
 	ginzap "github.com/gin-contrib/zap"
 	"github.com/gin-gonic/gin"
@@ -22,64 +26,210 @@ import (
 type RouterOptions struct {
 	logger *zap.Logger
 	db     *gorm.DB
+	// publicHost is the host the tunnel router is publicly reachable on, i.e yuka.dev
+	publicHost string
+	// tcpPortRange is the range public ports are allocated to TCP tunnels from, i.e 20000-20100
+	tcpPortRange string
+	// tunnelTLSConfig secures the connections from agents, when nil the tunnel listener is plaintext
+	tunnelTLSConfig *tls.Config
+	// tunnelHttps enables the HTTPS listener of the tunnel router, when nil tunnels are only served over HTTP
+	tunnelHttps *TunnelHttpsOptions
+}
+
+// TunnelHttpsOptions configures the HTTPS listener of the tunnel router, which terminates TLS with
+// certificates obtained through ACME. The CA validates domains over the plain HTTP listener, which must be
+// reachable on port 80.
+type TunnelHttpsOptions struct {
+	Port int
+	// DirectoryURL is the directory of the ACME CA, Let's Encrypt's when empty
+	DirectoryURL string
+	Email        string
+	// DNSProvider answers DNS-01 challenges so the subdomains of the public host share a wildcard
+	// certificate, without it each tunnel gets its own certificate
+	DNSProvider tls_helper.DNSProvider
+	// HTTPClient talks to the CA, it's only needed for a CA whose certificate isn't trusted by the system
+	HTTPClient *http.Client
 }
 
 type ApiRouterOptions struct {
 	RouterOptions
-	wsHandler *handlers.WsHandler
-	port      int
+	wsHandler            *handlers.WsHandler
+	domainHandler        *handlers.DomainHandler
+	tunnelSessionHandler *handlers.TunnelSessionHandler
+	port                 int
+	connectionPool       *streaming_connection.StreamingConnectionPool
 }
 type TunnelRouterOptions struct {
 	RouterOptions
 	port           int
-	connectionPool streaming_connection.StreamingConnectionPool
+	connectionPool *streaming_connection.StreamingConnectionPool
+	// readHeaderTimeout limits how long clients take to send the headers of a request. Bodies and responses
+	// aren't limited so uploads, long polls and streamed responses take as long as they need.
+	readHeaderTimeout time.Duration
+	// idleTimeout is how long keep-alive connections are kept open waiting for the next request
+	idleTimeout time.Duration
 }
 
 var (
 	g errgroup.Group
 )
 
-func NewRouterOptions(logger *zap.Logger, db *gorm.DB) RouterOptions {
+// tcpPortReleaseGracePeriod is how long a TCP tunnel keeps its port after its agent disconnects
+const tcpPortReleaseGracePeriod = 2 * time.Minute
+
+// tcpPortsPerOwner is how many public ports a user or organization can hold for their TCP and UDP tunnels
+const tcpPortsPerOwner = 10
+
+// tunnelReadHeaderTimeout and tunnelIdleTimeout are the timeouts of the tunnel router's listeners, see
+// TunnelRouterOptions
+const (
+	tunnelReadHeaderTimeout = 10 * time.Second
+	tunnelIdleTimeout       = 2 * time.Minute
+)
+
+// apiTlsPort is where the api server is served over TLS when the tunnel listener is, so agents using the
+// ws transport are as secure as those connecting to the tunnel listener
+const apiTlsPort = 8443
+
+// tlsPassthroughPort is where connections to TLS tunnels are accepted, they're routed without being decrypted
+const tlsPassthroughPort = 8087
+
+func NewRouterOptions(logger *zap.Logger, db *gorm.DB, publicHost string, tcpPortRange string, tunnelTLSConfig *tls.Config, tunnelHttps *TunnelHttpsOptions) RouterOptions {
+	if publicHost == "" {
+		publicHost = "localhost:8081"
+	}
+	if tcpPortRange == "" {
+		tcpPortRange = "20000-20100"
+	}
 	return RouterOptions{
-		logger: logger,
-		db:     db,
+		logger:          logger,
+		db:              db,
+		publicHost:      publicHost,
+		tcpPortRange:    tcpPortRange,
+		tunnelTLSConfig: tunnelTLSConfig,
+		tunnelHttps:     tunnelHttps,
 	}
 }
 
 func Run(ctx context.Context, routerOptions *RouterOptions) error {
-	wsHandler := handlers.NewWsHandler(routerOptions.logger, routerOptions.db)
-	connectionPool := streaming_connection.NewStreamingConnectionPool(routerOptions.logger)
+	connectionPool := streaming_connection.NewStreamingConnectionPool(routerOptions.logger, routerOptions.publicHost)
+	connectionPool.Subscribe(func(event streaming_connection.PoolEvent) {
+		routerOptions.logger.Sugar().Infof("Agent %s for hostname %s", event.Type, event.Hostname)
+	})
+
+	// This is required to stream TCP connections between server and yukactl clients
+	minPort, maxPort, err := streaming_connection.ParsePortRange(routerOptions.tcpPortRange)
+	if err != nil {
+		return err
+	}
+	apiTokenHandler := handlers.NewApiTokenHandler(routerOptions.logger, routerOptions.db)
+	domainHandler := handlers.NewDomainHandler(routerOptions.logger, routerOptions.db, routerOptions.publicHost)
+	// Tunnel sessions are recorded under the server's hostname, those left over from its last run are stale
+	instance, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+	tunnelSessionHandler := handlers.NewTunnelSessionHandler(routerOptions.logger, routerOptions.db, instance)
+	if err := tunnelSessionHandler.DeleteInstanceSessions(); err != nil {
+		return err
+	}
+	// HTTP tunnels are advertised on the HTTPS listener when there is one
+	httpsPort := 0
+	if routerOptions.tunnelHttps != nil {
+		httpsPort = routerOptions.tunnelHttps.Port
+	}
+	tcpTunnel := streaming_connection.NewTcpTunnel(routerOptions.logger, connectionPool, streaming_connection.TcpTunnelOptions{
+		ListenPort:             8085,
+		PublicHost:             routerOptions.publicHost,
+		Authenticator:          &apiTokenHandler,
+		PortAllocator:          streaming_connection.NewPortAllocator(routerOptions.logger, minPort, maxPort, tcpPortsPerOwner),
+		PortReleaseGracePeriod: tcpPortReleaseGracePeriod,
+		TLSConfig:              routerOptions.tunnelTLSConfig,
+		TlsPassthroughPort:     tlsPassthroughPort,
+		HttpsPort:              httpsPort,
+		Reservations:           &domainHandler,
+		TunnelRecorder:         &tunnelSessionHandler,
+	})
+	// Agents that can't reach the tunnel listener connect over a websocket to the api server instead
+	wsHandler := handlers.NewWsHandler(routerOptions.logger, routerOptions.db, tcpTunnel)
 
 	// This currently doens't do anything atm...
 	apiRouter := setupApiRouter(ctx, &ApiRouterOptions{
-		RouterOptions: *routerOptions,
-		wsHandler:     &wsHandler,
-		port:          8080,
+		RouterOptions:        *routerOptions,
+		wsHandler:            &wsHandler,
+		domainHandler:        &domainHandler,
+		tunnelSessionHandler: &tunnelSessionHandler,
+		port:                 8080,
+		connectionPool:       connectionPool,
 	})
 	g.Go(func() error {
 		return apiRouter.ListenAndServe()
 	})
+	if routerOptions.tunnelTLSConfig != nil {
+		apiTlsRouter := setupApiRouter(ctx, &ApiRouterOptions{
+			RouterOptions:        *routerOptions,
+			wsHandler:            &wsHandler,
+			domainHandler:        &domainHandler,
+			tunnelSessionHandler: &tunnelSessionHandler,
+			port:                 apiTlsPort,
+			connectionPool:       connectionPool,
+		})
+		apiTlsRouter.TLSConfig = routerOptions.tunnelTLSConfig
+		g.Go(func() error {
+			return apiTlsRouter.ListenAndServeTLS("", "")
+		})
+	}
 
 	// This runs a HTTP server that forwards connections onto yukactl clients
 	tunnelRouter := setupTunnelRouter(ctx, &TunnelRouterOptions{
-		RouterOptions:  *routerOptions,
-		port:           8081,
-		connectionPool: *connectionPool,
+		RouterOptions:     *routerOptions,
+		port:              8081,
+		connectionPool:    connectionPool,
+		readHeaderTimeout: tunnelReadHeaderTimeout,
+		idleTimeout:       tunnelIdleTimeout,
 	})
+	if routerOptions.tunnelHttps != nil {
+		acmeManager := newAcmeManager(routerOptions, connectionPool, &domainHandler)
+		// The CA's HTTP-01 challenges are answered by the plain HTTP listener
+		tunnelRouter.Handler = acmeManager.HTTPHandler(tunnelRouter.Handler)
+
+		tunnelHttpsRouter := setupTunnelRouter(ctx, &TunnelRouterOptions{
+			RouterOptions:     *routerOptions,
+			port:              routerOptions.tunnelHttps.Port,
+			connectionPool:    connectionPool,
+			readHeaderTimeout: tunnelReadHeaderTimeout,
+			idleTimeout:       tunnelIdleTimeout,
+		})
+		tunnelHttpsRouter.TLSConfig = acmeManager.TLSConfig()
+		g.Go(func() error {
+			return tunnelHttpsRouter.ListenAndServeTLS("", "")
+		})
+		g.Go(func() error {
+			return acmeManager.Run(ctx)
+		})
+	}
 	g.Go(func() error {
 		return tunnelRouter.ListenAndServe()
 	})
 	// This runs a raw TCP server that forwards connections onto yukactl clients
-	// TODO: Figure out how we can integrate the connection pool with this
 	tcpServer := streaming_connection.NewTcpServer(routerOptions.logger, 8086, connectionPool)
 	g.Go(func() error {
 		return tcpServer.Listen(ctx)
 	})
-	// This is required to stream TCP connections between server and yukactl clients
-	tcpTunnel := streaming_connection.NewTcpTunnel(routerOptions.logger, 8085, connectionPool)
+	g.Go(func() error {
+		return tcpServer.ListenTls(ctx, tlsPassthroughPort)
+	})
 	g.Go(func() error {
 		return tcpTunnel.Listen(ctx)
 	})
+	// Agents connecting over QUIC use the same port as the tunnel listener, over UDP
+	quicTunnel := streaming_connection.NewQuicTunnel(routerOptions.logger, tcpTunnel, streaming_connection.QuicTunnelOptions{
+		ListenPort: 8085,
+		TLSConfig:  routerOptions.tunnelTLSConfig,
+	})
+	g.Go(func() error {
+		return quicTunnel.Listen(ctx)
+	})
 
 	if err := g.Wait(); err != nil {
 		return err
@@ -108,12 +258,53 @@ func setupApiRouter(ctx context.Context, routerOptions *ApiRouterOptions) *http.
 
 	v1 := r.Group("/v1")
 
-	// Users
+	// Users, once created they can only be managed by themselves along with their API tokens
 	userHandler := handlers.NewUserHandler(routerOptions.logger, routerOptions.db)
+	apiTokenHandler := handlers.NewApiTokenHandler(routerOptions.logger, routerOptions.db)
 	v1.POST("/users", createUser(userHandler))
-	v1.GET("/users/:id", getUser(userHandler))
-	v1.PUT("/users/:id", updateUser(userHandler))
-	v1.DELETE("/users/:id", deleteUser(userHandler))
+	users := v1.Group("/users/:id", authenticateUser(apiTokenHandler), requireSameUser())
+	users.GET("", getUser(userHandler))
+	users.PUT("", updateUser(userHandler))
+	users.DELETE("", deleteUser(userHandler))
+
+	// API tokens
+	users.POST("/tokens", createApiToken(apiTokenHandler))
+	users.GET("/tokens", getApiTokens(apiTokenHandler))
+	users.DELETE("/tokens/:tokenId", deleteApiToken(apiTokenHandler))
+
+	// Organizations, these act as the user of the API token the request is authenticated with
+	organizationHandler := handlers.NewOrganizationHandler(routerOptions.logger, routerOptions.db)
+	organizations := v1.Group("/organizations", authenticateUser(apiTokenHandler))
+	organizations.POST("", createOrganization(organizationHandler))
+	organizations.GET("", getOrganizations(organizationHandler))
+	organizations.GET("/:id", getOrganization(organizationHandler))
+	organizations.PUT("/:id", updateOrganization(organizationHandler))
+	organizations.DELETE("/:id", deleteOrganization(organizationHandler))
+	organizations.GET("/:id/members", getOrganizationMembers(organizationHandler))
+	organizations.POST("/:id/members", addOrganizationMember(organizationHandler))
+	organizations.PUT("/:id/members/:userId", updateOrganizationMember(organizationHandler))
+	organizations.DELETE("/:id/members/:userId", removeOrganizationMember(organizationHandler))
+
+	// Invitations
+	invitationHandler := handlers.NewInvitationHandler(routerOptions.logger, routerOptions.db)
+	organizations.POST("/:id/invitations", createInvitation(invitationHandler))
+	organizations.GET("/:id/invitations", getInvitations(invitationHandler))
+	organizations.DELETE("/:id/invitations/:invitationId", revokeInvitation(invitationHandler))
+	v1.POST("/invitations/accept", authenticateUser(apiTokenHandler), acceptInvitation(invitationHandler))
+
+	// Reserved domains
+	domains := v1.Group("/domains", authenticateUser(apiTokenHandler))
+	domains.POST("", reserveDomain(*routerOptions.domainHandler))
+	domains.GET("", getDomains(*routerOptions.domainHandler))
+	domains.DELETE("/:id", releaseDomain(*routerOptions.domainHandler))
+	domains.POST("/:id/verify", verifyDomain(*routerOptions.domainHandler))
+
+	// Tunnels being served by agents, across every server sharing the database
+	v1.GET("/tunnels", authenticateUser(apiTokenHandler), getTunnelSessions(*routerOptions.tunnelSessionHandler))
+
+	// Agent connections
+	connectionHandler := handlers.NewConnectionHandler(routerOptions.logger, routerOptions.db, routerOptions.connectionPool)
+	v1.GET("/connections", authenticateUser(apiTokenHandler), getConnections(connectionHandler))
 
 	// Setup websockets
 	r.GET("/ws", handleWsConnection(*routerOptions.wsHandler))
@@ -152,9 +343,53 @@ func setupTunnelRouter(ctx context.Context, routerOptions *TunnelRouterOptions)
 	r.Any("/*tunnelPath", tunnelRequest(tunnelHandler))
 
 	return &http.Server{
-		Addr:         fmt.Sprintf(":%v", routerOptions.port),
-		Handler:      r,
-		ReadTimeout:  5 * time.Second,
-		WriteTimeout: 10 * time.Second,
+		Addr:              fmt.Sprintf(":%v", routerOptions.port),
+		Handler:           r,
+		ReadHeaderTimeout: routerOptions.readHeaderTimeout,
+		IdleTimeout:       routerOptions.idleTimeout,
+	}
+}
+
+// newAcmeManager obtains the certificates for the tunnel router's HTTPS listener, they're stored in the
+// database to be shared by every server instance
+func newAcmeManager(routerOptions *RouterOptions, connectionPool *streaming_connection.StreamingConnectionPool, reservations streaming_connection.Reservations) *tls_helper.AcmeManager {
+	certificateHandler := handlers.NewCertificateHandler(routerOptions.logger, routerOptions.db)
+	publicHostname := routerOptions.publicHost
+	if host, _, err := net.SplitHostPort(publicHostname); err == nil {
+		publicHostname = host
+	}
+	return tls_helper.NewAcmeManager(routerOptions.logger, tls_helper.AcmeOptions{
+		DirectoryURL: routerOptions.tunnelHttps.DirectoryURL,
+		Email:        routerOptions.tunnelHttps.Email,
+		Store:        &certificateHandler,
+		BaseDomain:   publicHostname,
+		DNSProvider:  routerOptions.tunnelHttps.DNSProvider,
+		HostPolicy:   tunnelHostPolicy(connectionPool, reservations),
+		HTTPClient:   routerOptions.tunnelHttps.HTTPClient,
+	})
+}
+
+// tunnelHostPolicy only allows certificates for hosts with a tunnel, either a subdomain of the public host or
+// a custom domain with a verified reservation, so clients can't have certificates obtained for any name they like
+func tunnelHostPolicy(connectionPool *streaming_connection.StreamingConnectionPool, reservations streaming_connection.Reservations) func(context.Context, string) error {
+	return func(ctx context.Context, host string) error {
+		hostname, err := connectionPool.CanonicalHostname(host)
+		if err != nil {
+			return fmt.Errorf("no tunnel for host %s: %v", host, err)
+		}
+		if len(connectionPool.GetConnections(hostname)) == 0 {
+			return fmt.Errorf("no tunnel for host %s", host)
+		}
+		// The tunnel may have outlived the reservation it was claimed with
+		if streaming_connection.IsCustomDomain(hostname) {
+			owner, err := reservations.ReservedFor(hostname)
+			if err != nil {
+				return err
+			}
+			if owner == "" || owner != connectionPool.Owner(hostname) {
+				return fmt.Errorf("custom domain %s isn't verified", host)
+			}
+		}
+		return nil
 	}
 }
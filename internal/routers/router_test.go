@@ -0,0 +1,181 @@
+package routers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"yuka/internal/database"
+	"yuka/internal/handlers"
+	"yuka/pkg/streaming_connection"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// agentListener accepts the streams opened to an agent as connections so an http.Server can answer them
+type agentListener struct {
+	*streaming_connection.MuxSession
+}
+
+func (l agentListener) Accept() (net.Conn, error) {
+	stream, err := l.AcceptStream()
+	if err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+func (l agentListener) Addr() net.Addr {
+	return l.RemoteAddr()
+}
+
+// serveTunnel registers an agent serving handler under hostname and returns the address of a tunnel router
+// for the pool
+func serveTunnel(t *testing.T, hostname string, handler http.Handler, options TunnelRouterOptions) string {
+	pool := streaming_connection.NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	serverConn, agentConn := net.Pipe()
+	server := streaming_connection.NewMuxSession(zap.NewNop(), serverConn, false, nil)
+	agent := streaming_connection.NewMuxSession(zap.NewNop(), agentConn, true, nil)
+	t.Cleanup(func() {
+		agent.Close()
+		server.Close()
+	})
+	require.NoError(t, pool.AddConnection(hostname, "", server))
+	go http.Serve(agentListener{agent}, handler)
+
+	options.RouterOptions = RouterOptions{logger: zap.NewNop()}
+	options.connectionPool = pool
+	router := setupTunnelRouter(context.Background(), &options)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go router.Serve(listener)
+	t.Cleanup(func() { router.Close() })
+	return listener.Addr().String()
+}
+
+func TestTunnelRouterStreamsPastTimeouts(t *testing.T) {
+	const chunks, interval = 5, 100 * time.Millisecond
+	address := serveTunnel(t, "foo", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for i := 0; i < chunks; i++ {
+			fmt.Fprintf(w, "%d:%d\n", i, len(body))
+			w.(http.Flusher).Flush()
+			time.Sleep(interval)
+		}
+	}), TunnelRouterOptions{readHeaderTimeout: interval, idleTimeout: interval})
+
+	// Both the upload and the response take several times longer than the timeouts of the router
+	bodyReader, bodyWriter := io.Pipe()
+	go func() {
+		for i := 0; i < chunks; i++ {
+			bodyWriter.Write([]byte("chunk"))
+			time.Sleep(interval)
+		}
+		bodyWriter.Close()
+	}()
+	req, err := http.NewRequest(http.MethodPost, "http://"+address+"/upload", bodyReader)
+	require.NoError(t, err)
+	req.Host = "foo.yuka.dev"
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "0:25\n1:25\n2:25\n3:25\n4:25\n", string(body))
+}
+
+// newTestApiRouter returns the api router backed by an empty test database
+func newTestApiRouter(t *testing.T) (http.Handler, *gorm.DB) {
+	return newTestApiRouterWithPool(t, streaming_connection.NewStreamingConnectionPool(zap.NewNop(), "yuka.dev"))
+}
+
+// newTestApiRouterWithPool is newTestApiRouter reporting the agent connections registered in pool
+func newTestApiRouterWithPool(t *testing.T, pool *streaming_connection.StreamingConnectionPool) (http.Handler, *gorm.DB) {
+	gin.SetMode(gin.TestMode)
+	db, err := database.ConnectTestDatabase(zap.NewNop())
+	require.NoError(t, err)
+	domainHandler := handlers.NewDomainHandler(zap.NewNop(), db, "yuka.dev")
+	// Tests don't publish verification records
+	domainHandler.LookupTXT = func(ctx context.Context, name string) ([]string, error) {
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	tunnelSessionHandler := handlers.NewTunnelSessionHandler(zap.NewNop(), db, "test")
+	server := setupApiRouter(context.Background(), &ApiRouterOptions{
+		RouterOptions:        RouterOptions{logger: zap.NewNop(), db: db, publicHost: "yuka.dev"},
+		wsHandler:            &handlers.WsHandler{},
+		domainHandler:        &domainHandler,
+		tunnelSessionHandler: &tunnelSessionHandler,
+		connectionPool:       pool,
+	})
+	return server.Handler, db
+}
+
+// createTestUser signs up a user through the api and returns its id along with the api token it's created with
+func createTestUser(t *testing.T, router http.Handler, username string) (string, string) {
+	var user handlers.CreateUserResponse
+	require.Equal(t, http.StatusOK, serveApi(t, router, http.MethodPost, "/v1/users", "",
+		handlers.CreateUserInput{AuthID: username, Username: username, DeviceToken: username}, &user))
+	require.NotEmpty(t, user.Token)
+	return user.ID.String(), user.Token
+}
+
+// serveApi sends a request with body encoded as JSON, authenticated with token when it isn't empty, and
+// decodes the response into out when it isn't nil
+func serveApi(t *testing.T, router http.Handler, method string, path string, token string, body any, out any) int {
+	var reader io.Reader = http.NoBody
+	if body != nil {
+		b, err := json.Marshal(body)
+		require.NoError(t, err)
+		reader = bytes.NewReader(b)
+	}
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	if out != nil && recorder.Code < http.StatusBadRequest {
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), out))
+	}
+	return recorder.Code
+}
+
+// testReservations maps hostnames to the owners they're reserved for
+type testReservations map[string]string
+
+func (r testReservations) ReservedFor(hostname string) (string, error) {
+	return r[hostname], nil
+}
+
+func TestTunnelHostPolicyRequiresVerifiedCustomDomains(t *testing.T) {
+	pool := streaming_connection.NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	addTestConnection(t, pool, "foo", "organization:acme")
+	addTestConnection(t, pool, "app.acme.com", "organization:acme")
+	addTestConnection(t, pool, "app.example.com", "organization:acme")
+	policy := tunnelHostPolicy(pool, testReservations{"app.acme.com": "organization:acme", "app.example.com": "organization:other"})
+
+	assert.NoError(t, policy(context.Background(), "foo.yuka.dev"))
+	assert.NoError(t, policy(context.Background(), "app.acme.com"))
+	assert.Error(t, policy(context.Background(), "bar.yuka.dev"))
+	// The tunnel outlived its reservation, which has been verified by another organization since
+	assert.Error(t, policy(context.Background(), "app.example.com"))
+	assert.Error(t, policy(context.Background(), "unknown.com"))
+}
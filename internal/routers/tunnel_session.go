@@ -0,0 +1,32 @@
+package routers
+
+import (
+	"net/http"
+
+	"yuka/internal/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getTunnelSessions gets the tunnels being served for the authenticated user
+// @Summary      Get Tunnels
+// @Id  		 getTunnels
+// @Tags         Tunnels
+// @Description  Gets the tunnels being served by the authenticated user's agents and by the agents of the organizations they're a member of
+// @Security     ApiToken
+// @Accept	     json
+// @Produce      json
+// @Success      200  {array}   models.TunnelSession
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      500  {object}  models.BaseError
+// @Router       /v1/tunnels [get]
+func getTunnelSessions(handler handlers.TunnelSessionHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tunnelSessions, err := handler.FindTunnelSessions(c.GetString(userIdKey))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, tunnelSessions)
+	}
+}
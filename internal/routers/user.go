@@ -1,9 +1,11 @@
 package routers
 
 import (
+	"errors"
 	"net/http"
 
 	"yuka/internal/handlers"
+	"yuka/internal/models"
 
 	"github.com/gin-gonic/gin"
 )
@@ -14,14 +16,15 @@ import (
 // @Summary      Create User
 // @Id  		 createUser
 // @Tags         Users
-// @Description  Creates a user
+// @Description  Creates a user along with their first API token, which is only returned in this response. Further tokens are created with it.
 // @Accept	     json
 // @Produce      json
 // @Param		 create body handlers.CreateUserInput true "User Create"
-// @Success      200  {object}  models.User
+// @Success      200  {object}  handlers.CreateUserResponse
 // @Failure      400  {object}  models.ValidationError
+// @Failure      409  {object}  models.ConflictsError
 // @Failure      500  {object}  models.BaseError
-// @Router       /api/v1/users [post]
+// @Router       /v1/users [post]
 func createUser(handler handlers.UserHandler) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var input handlers.CreateUserInput
@@ -31,6 +34,10 @@ func createUser(handler handlers.UserHandler) gin.HandlerFunc {
 		}
 		user, err := handler.CreateUser(input)
 		if err != nil {
+			if errors.Is(err, handlers.ErrUserExists) {
+				c.JSON(http.StatusConflict, models.NewConflictsError(input.AuthID))
+				return
+			}
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
@@ -43,16 +50,19 @@ func createUser(handler handlers.UserHandler) gin.HandlerFunc {
 // @Summary      Get User for specified id
 // @Id  		 getUser
 // @Tags         Users
-// @Description  Gets users
+// @Description  Gets the authenticated user along with their organizations
+// @Security     ApiToken
 // @Param        id    path      string          true  "User ID"
 // @Accept	     json
 // @Produce      json
 // @Success      200  {object}  models.User
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
 // @Failure      500  {object}  models.BaseError
-// @Router       /api/v1/users/{id} [get]
+// @Router       /v1/users/{id} [get]
 func getUser(handler handlers.UserHandler) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		user, err := handler.FindUser(handlers.FindUserKeyAuthID, c.Param("id"))
+		user, err := handler.FindUser(handlers.FindUserKeyUserID, c.Param("id"))
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
@@ -65,15 +75,18 @@ func getUser(handler handlers.UserHandler) gin.HandlerFunc {
 // @Summary      Update User
 // @Id  		 updateUser
 // @Tags         Users
-// @Description  Updates a user
+// @Description  Updates the authenticated user, the current organization can only be set to one the user is a member of
+// @Security     ApiToken
 // @Param        id    path      string          true  "User ID"
 // @Accept	     json
 // @Produce      json
 // @Param		 create body handlers.UpdateUserInput true "User Update"
 // @Success      200  {object}  models.User
 // @Failure      400  {object}  models.ValidationError
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
 // @Failure      500  {object}  models.BaseError
-// @Router       /api/v1/users/{id} [put]
+// @Router       /v1/users/{id} [put]
 func updateUser(handler handlers.UserHandler) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var input handlers.UpdateUserInput
@@ -83,6 +96,10 @@ func updateUser(handler handlers.UserHandler) gin.HandlerFunc {
 		}
 		user, err := handler.UpdateUser(c.Param("id"), input)
 		if err != nil {
+			if errors.Is(err, handlers.ErrNotOrganizationMember) {
+				c.JSON(http.StatusForbidden, models.NewNotAllowedError(err.Error()))
+				return
+			}
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
@@ -95,13 +112,16 @@ func updateUser(handler handlers.UserHandler) gin.HandlerFunc {
 // @Summary      Delete User
 // @Id  		 deleteUser
 // @Tags         Users
-// @Description  Deletes a user
+// @Description  Deletes the authenticated user
+// @Security     ApiToken
 // @Param        id    path      string          true  "User ID"
 // @Accept	     json
 // @Produce      json
 // @Success      200  {object}  models.User
+// @Failure      401  {object}  models.UnauthorizedError
+// @Failure      403  {object}  models.NotAllowedError
 // @Failure      500  {object}  models.BaseError
-// @Router       /api/v1/users/{id} [delete]
+// @Router       /v1/users/{id} [delete]
 func deleteUser(handler handlers.UserHandler) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		if err := handler.DeleteUser(c.Param("id")); err != nil {
@@ -0,0 +1,358 @@
+package http_helper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+/*
+ * The binary encoding streams a request or response as a sequence of frames, each with a fixed size header:
+ *
+ *   | type (1) | length (4) | payload (length) |
+ *
+ * A message is a header frame, any number of body frames and an end frame. Header frames hold the method
+ * and URL of a request or the status code of a response followed by the headers, body frames hold the
+ * next chunk of the body as is and the end frame holds the trailers. Strings are prefixed with their length
+ * as a uvarint and headers are written as the number of names followed by each name and its values.
+ **/
+
+const (
+	frameHeaderSize = 5
+
+	// maxBodyChunkSize is the most body written in a single frame
+	maxBodyChunkSize = 32 * 1024
+	// maxHeaderFrameSize bounds the header and end frames as, unlike the body, they're read into memory
+	maxHeaderFrameSize = 1024 * 1024
+)
+
+type frameType uint8
+
+const (
+	// frameRequestHeader starts a request, the payload is the method, URL and headers
+	frameRequestHeader frameType = iota + 1
+	// frameResponseHeader starts a response, the payload is the status code and headers
+	frameResponseHeader
+	// frameBody carries the next chunk of the body
+	frameBody
+	// frameEnd ends the body, the payload is the trailers
+	frameEnd
+)
+
+var (
+	ErrFrameTooLarge   = errors.New("frame is too large")
+	ErrUnexpectedFrame = errors.New("unexpected frame")
+	ErrMalformedFrame  = errors.New("malformed frame")
+)
+
+// Encoder writes requests and responses to a stream in the binary encoding
+type Encoder struct {
+	w io.Writer
+}
+
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// EncodeRequest writes req followed by body, req.Body is written if body is nil. The trailers are written
+// once body has been read to the end so they can be set while it's being read.
+func (e *Encoder) EncodeRequest(req *HttpRequest, body io.Reader) error {
+	payload := appendString(nil, req.Method)
+	payload = appendString(payload, req.URL)
+	payload = appendHeader(payload, req.Headers)
+	if err := e.writeFrame(frameRequestHeader, payload); err != nil {
+		return err
+	}
+	if body == nil {
+		body = bytes.NewReader(req.Body)
+	}
+	return e.writeBody(body, &req.Trailers)
+}
+
+// EncodeResponse writes resp followed by body, resp.Body is written if body is nil. The trailers are written
+// once body has been read to the end so they can be set while it's being read.
+func (e *Encoder) EncodeResponse(resp *HttpResponse, body io.Reader) error {
+	payload := binary.AppendUvarint(nil, uint64(resp.StatusCode))
+	payload = appendHeader(payload, resp.Headers)
+	if err := e.writeFrame(frameResponseHeader, payload); err != nil {
+		return err
+	}
+	if body == nil {
+		body = bytes.NewReader(resp.Body)
+	}
+	return e.writeBody(body, &resp.Trailers)
+}
+
+// writeBody writes every chunk read from body in its own frame so streamed bodies, such as server-sent
+// events, are passed on as they arrive
+func (e *Encoder) writeBody(body io.Reader, trailers *http.Header) error {
+	buf := make([]byte, maxBodyChunkSize)
+	for {
+		n, err := body.Read(buf)
+		if n > 0 {
+			if err := e.writeFrame(frameBody, buf[:n]); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("unable to read body: %v", err)
+		}
+	}
+	return e.writeFrame(frameEnd, appendHeader(nil, *trailers))
+}
+
+// writeFrame writes the frame in a single call so frames aren't split when the writer is shared
+func (e *Encoder) writeFrame(t frameType, payload []byte) error {
+	frame := make([]byte, frameHeaderSize, frameHeaderSize+len(payload))
+	frame[0] = uint8(t)
+	binary.BigEndian.PutUint32(frame[1:frameHeaderSize], uint32(len(payload)))
+	frame = append(frame, payload...)
+	_, err := e.w.Write(frame)
+	return err
+}
+
+// Decoder reads requests and responses in the binary encoding from a stream
+type Decoder struct {
+	r io.Reader
+	// body is the body of the last message decoded, it's drained before the next message is decoded
+	body *bodyReader
+}
+
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: r}
+}
+
+// DecodeRequest reads the next request from the stream. The body is returned as a reader which must be
+// read before decoding the next message, otherwise what's left of it is discarded. The request's trailers
+// are set once the body has been read to the end.
+func (d *Decoder) DecodeRequest() (*HttpRequest, io.Reader, error) {
+	payload, err := d.readMessageHeader(frameRequestHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req := &HttpRequest{}
+	r := bytes.NewReader(payload)
+	if req.Method, err = readString(r); err != nil {
+		return nil, nil, err
+	}
+	if req.URL, err = readString(r); err != nil {
+		return nil, nil, err
+	}
+	if req.Headers, err = readHeader(r); err != nil {
+		return nil, nil, err
+	}
+	if r.Len() != 0 {
+		return nil, nil, fmt.Errorf("%w: %d trailing bytes in request header", ErrMalformedFrame, r.Len())
+	}
+	d.body = &bodyReader{decoder: d, trailers: &req.Trailers}
+	return req, d.body, nil
+}
+
+// DecodeResponse reads the next response from the stream. The body is returned as a reader which must be
+// read before decoding the next message, otherwise what's left of it is discarded. The response's
+// trailers are set once the body has been read to the end.
+func (d *Decoder) DecodeResponse() (*HttpResponse, io.Reader, error) {
+	payload, err := d.readMessageHeader(frameResponseHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := &HttpResponse{}
+	r := bytes.NewReader(payload)
+	statusCode, err := binary.ReadUvarint(r)
+	if err != nil || statusCode > 999 {
+		return nil, nil, fmt.Errorf("%w: invalid status code", ErrMalformedFrame)
+	}
+	resp.StatusCode = int(statusCode)
+	if resp.Headers, err = readHeader(r); err != nil {
+		return nil, nil, err
+	}
+	if r.Len() != 0 {
+		return nil, nil, fmt.Errorf("%w: %d trailing bytes in response header", ErrMalformedFrame, r.Len())
+	}
+	d.body = &bodyReader{decoder: d, trailers: &resp.Trailers}
+	return resp, d.body, nil
+}
+
+// readMessageHeader discards what's left of the previous message and reads the header frame of the next
+func (d *Decoder) readMessageHeader(expected frameType) ([]byte, error) {
+	if d.body != nil {
+		if _, err := io.Copy(io.Discard, d.body); err != nil {
+			return nil, err
+		}
+		d.body = nil
+	}
+
+	t, length, err := d.readFrameHeader()
+	if err != nil {
+		return nil, err
+	}
+	if t != expected {
+		return nil, fmt.Errorf("%w: expected header frame %d but got %d", ErrUnexpectedFrame, expected, t)
+	}
+	return d.readPayload(length)
+}
+
+func (d *Decoder) readFrameHeader() (frameType, uint32, error) {
+	var hdr [frameHeaderSize]byte
+	if _, err := io.ReadFull(d.r, hdr[:]); err != nil {
+		return 0, 0, err
+	}
+	return frameType(hdr[0]), binary.BigEndian.Uint32(hdr[1:]), nil
+}
+
+func (d *Decoder) readPayload(length uint32) ([]byte, error) {
+	if length > maxHeaderFrameSize {
+		return nil, fmt.Errorf("%w: %d bytes", ErrFrameTooLarge, length)
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(d.r, payload); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return payload, nil
+}
+
+// bodyReader reads the body frames of a message, returning io.EOF once the end frame has been read
+type bodyReader struct {
+	decoder *Decoder
+	// remaining is what's left of the current body frame
+	remaining uint32
+	trailers  *http.Header
+	err       error
+}
+
+func (b *bodyReader) Read(p []byte) (int, error) {
+	for b.err == nil && b.remaining == 0 {
+		b.err = b.nextFrame()
+	}
+	if b.err != nil {
+		return 0, b.err
+	}
+
+	if uint32(len(p)) > b.remaining {
+		p = p[:b.remaining]
+	}
+	n, err := b.decoder.r.Read(p)
+	b.remaining -= uint32(n)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		b.err = err
+	}
+	return n, err
+}
+
+func (b *bodyReader) nextFrame() error {
+	t, length, err := b.decoder.readFrameHeader()
+	if err != nil {
+		return unexpectedEOF(err)
+	}
+	switch t {
+	case frameBody:
+		b.remaining = length
+		return nil
+	case frameEnd:
+		payload, err := b.decoder.readPayload(length)
+		if err != nil {
+			return err
+		}
+		r := bytes.NewReader(payload)
+		trailers, err := readHeader(r)
+		if err != nil {
+			return err
+		}
+		if r.Len() != 0 {
+			return fmt.Errorf("%w: %d trailing bytes in end frame", ErrMalformedFrame, r.Len())
+		}
+		if len(trailers) > 0 {
+			*b.trailers = trailers
+		}
+		return io.EOF
+	default:
+		return fmt.Errorf("%w: expected body frame but got %d", ErrUnexpectedFrame, t)
+	}
+}
+
+// unexpectedEOF converts io.EOF to io.ErrUnexpectedEOF for when the stream ends part way through a message
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func appendString(b []byte, s string) []byte {
+	b = binary.AppendUvarint(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+func appendHeader(b []byte, header http.Header) []byte {
+	b = binary.AppendUvarint(b, uint64(len(header)))
+	for name, values := range header {
+		b = appendString(b, name)
+		b = binary.AppendUvarint(b, uint64(len(values)))
+		for _, value := range values {
+			b = appendString(b, value)
+		}
+	}
+	return b
+}
+
+func readString(r *bytes.Reader) (string, error) {
+	length, err := readLength(r)
+	if err != nil {
+		return "", err
+	}
+	s := make([]byte, length)
+	if _, err := io.ReadFull(r, s); err != nil {
+		return "", fmt.Errorf("%w: truncated string", ErrMalformedFrame)
+	}
+	return string(s), nil
+}
+
+func readHeader(r *bytes.Reader) (http.Header, error) {
+	count, err := readLength(r)
+	if err != nil {
+		return nil, err
+	}
+	header := make(http.Header, count)
+	for range count {
+		name, err := readString(r)
+		if err != nil {
+			return nil, err
+		}
+		valueCount, err := readLength(r)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]string, valueCount)
+		for i := range values {
+			if values[i], err = readString(r); err != nil {
+				return nil, err
+			}
+		}
+		header[name] = values
+	}
+	return header, nil
+}
+
+// readLength reads a uvarint length or count, each element takes at least a byte so anything larger than
+// what's left of the frame can't be valid
+func readLength(r *bytes.Reader) (int, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid length", ErrMalformedFrame)
+	}
+	if length > uint64(r.Len()) {
+		return 0, fmt.Errorf("%w: length %d exceeds the frame", ErrMalformedFrame, length)
+	}
+	return int(length), nil
+}
@@ -0,0 +1,189 @@
+package http_helper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecodeRequest(t *testing.T) {
+	req := NewHttpRequest(http.MethodPost, "/upload?name=a%20b", http.Header{
+		"Content-Type": {"application/octet-stream"},
+		"X-Multi":      {"one", "two"},
+	}, nil)
+	req.Trailers = http.Header{"X-Checksum": {"abc123"}}
+	body := bytes.Repeat([]byte("0123456789"), 10000)
+
+	var buf bytes.Buffer
+	require.NoError(t, NewEncoder(&buf).EncodeRequest(req, bytes.NewReader(body)))
+
+	decoded, decodedBody, err := NewDecoder(&buf).DecodeRequest()
+	require.NoError(t, err)
+	assert.Equal(t, req.Method, decoded.Method)
+	assert.Equal(t, req.URL, decoded.URL)
+	assert.Equal(t, req.Headers, decoded.Headers)
+	// Trailers are only known once the body has been read
+	assert.Nil(t, decoded.Trailers)
+
+	b, err := io.ReadAll(decodedBody)
+	require.NoError(t, err)
+	assert.Equal(t, body, b)
+	assert.Equal(t, req.Trailers, decoded.Trailers)
+	assert.Zero(t, buf.Len())
+}
+
+func TestEncodeDecodeResponse(t *testing.T) {
+	resp := NewHttpResponse(http.StatusCreated, http.Header{"X-Upstream": {"yes"}}, []byte("created"))
+
+	var buf bytes.Buffer
+	require.NoError(t, NewEncoder(&buf).EncodeResponse(resp, nil))
+
+	decoded, body, err := NewDecoder(&buf).DecodeResponse()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, decoded.StatusCode)
+	assert.Equal(t, resp.Headers, decoded.Headers)
+	b, err := io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, "created", string(b))
+	assert.Nil(t, decoded.Trailers)
+}
+
+func TestDecodeStreamsBody(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	bodyReader, bodyWriter := io.Pipe()
+	go NewEncoder(server).EncodeResponse(NewHttpResponse(http.StatusOK, http.Header{"Content-Type": {"text/event-stream"}}, nil), bodyReader)
+
+	resp, body, err := NewDecoder(client).DecodeResponse()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// Each event has to reach the decoder before the next one is written
+	buf := make([]byte, 64)
+	for _, event := range []string{"data: one\n\n", "data: two\n\n"} {
+		go bodyWriter.Write([]byte(event))
+		client.SetReadDeadline(time.Now().Add(time.Second))
+		n, err := body.Read(buf)
+		require.NoError(t, err)
+		assert.Equal(t, event, string(buf[:n]))
+	}
+
+	bodyWriter.Close()
+	n, err := body.Read(buf)
+	assert.Zero(t, n)
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestDecodeSkipsUnreadBody(t *testing.T) {
+	var buf bytes.Buffer
+	encoder := NewEncoder(&buf)
+	require.NoError(t, encoder.EncodeRequest(NewHttpRequest(http.MethodPut, "/first", nil, []byte("ignored")), nil))
+	require.NoError(t, encoder.EncodeRequest(NewHttpRequest(http.MethodGet, "/second", nil, nil), nil))
+
+	decoder := NewDecoder(&buf)
+	first, _, err := decoder.DecodeRequest()
+	require.NoError(t, err)
+	assert.Equal(t, "/first", first.URL)
+
+	second, body, err := decoder.DecodeRequest()
+	require.NoError(t, err)
+	assert.Equal(t, "/second", second.URL)
+	b, err := io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Empty(t, b)
+
+	_, _, err = decoder.DecodeRequest()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestDecodeErrors(t *testing.T) {
+	var response bytes.Buffer
+	require.NoError(t, NewEncoder(&response).EncodeResponse(NewHttpResponse(http.StatusOK, nil, []byte("body")), nil))
+	full := response.Bytes()
+
+	tooLarge := []byte{uint8(frameRequestHeader), 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(tooLarge[1:], maxHeaderFrameSize+1)
+
+	tests := []struct {
+		name     string
+		data     []byte
+		expected error
+	}{
+		{"Response instead of request", full, ErrUnexpectedFrame},
+		{"Header frame too large", tooLarge, ErrFrameTooLarge},
+		{"Truncated header frame", []byte{uint8(frameRequestHeader), 0, 0, 0, 10, 1}, io.ErrUnexpectedEOF},
+		{"Malformed header frame", []byte{uint8(frameRequestHeader), 0, 0, 0, 1, 5}, ErrMalformedFrame},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := NewDecoder(bytes.NewReader(test.data)).DecodeRequest()
+			assert.ErrorIs(t, err, test.expected)
+		})
+	}
+
+	t.Run("Truncated body", func(t *testing.T) {
+		_, body, err := NewDecoder(bytes.NewReader(full[:len(full)-frameHeaderSize-2])).DecodeResponse()
+		require.NoError(t, err)
+		_, err = io.ReadAll(body)
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+}
+
+func FuzzRequestRoundTrip(f *testing.F) {
+	f.Add("GET", "/", "Accept", "*/*", []byte(nil))
+	f.Add("POST", "/upload?x=1", "Content-Type", "application/json", []byte(`{"a":1}`))
+	f.Fuzz(func(t *testing.T, method string, url string, name string, value string, body []byte) {
+		req := NewHttpRequest(method, url, http.Header{name: {value}}, nil)
+
+		var buf bytes.Buffer
+		require.NoError(t, NewEncoder(&buf).EncodeRequest(req, bytes.NewReader(body)))
+
+		decoded, decodedBody, err := NewDecoder(&buf).DecodeRequest()
+		require.NoError(t, err)
+		b, err := io.ReadAll(decodedBody)
+		require.NoError(t, err)
+		assert.Equal(t, method, decoded.Method)
+		assert.Equal(t, url, decoded.URL)
+		assert.Equal(t, req.Headers, decoded.Headers)
+		assert.Equal(t, len(body), len(b))
+		assert.True(t, bytes.Equal(body, b))
+	})
+}
+
+func FuzzDecodeRequest(f *testing.F) {
+	var buf bytes.Buffer
+	req := NewHttpRequest(http.MethodPost, "/", http.Header{"X-Test": {"a", "b"}}, []byte("body"))
+	req.Trailers = http.Header{"X-Checksum": {"abc"}}
+	NewEncoder(&buf).EncodeRequest(req, nil)
+	f.Add(buf.Bytes())
+	f.Add([]byte{uint8(frameRequestHeader), 0, 0, 0, 3, 0, 0, 0, uint8(frameEnd), 0, 0, 0, 1, 0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Anything that decodes has to encode back to a message that decodes the same
+		decoded, body, err := NewDecoder(bytes.NewReader(data)).DecodeRequest()
+		if err != nil {
+			return
+		}
+		b, err := io.ReadAll(body)
+		if err != nil {
+			return
+		}
+
+		var reencoded bytes.Buffer
+		require.NoError(t, NewEncoder(&reencoded).EncodeRequest(decoded, bytes.NewReader(b)))
+		again, againBody, err := NewDecoder(&reencoded).DecodeRequest()
+		require.NoError(t, err)
+		againB, err := io.ReadAll(againBody)
+		require.NoError(t, err)
+		assert.Equal(t, decoded, again)
+		assert.True(t, bytes.Equal(b, againB))
+	})
+}
@@ -0,0 +1,61 @@
+package http_helper
+
+import (
+	"io"
+	"net/http"
+	"strings"
+)
+
+// hopByHopHeaders only apply to a single connection and so aren't forwarded by proxies, see RFC 9110 section 7.6.1
+var hopByHopHeaders = []string{
+	"Connection",
+	"Proxy-Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+// RemoveHopByHopHeaders removes the headers that only apply to a single connection from header, along with
+// any other headers listed in its Connection header
+func RemoveHopByHopHeaders(header http.Header) {
+	for _, value := range header.Values("Connection") {
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				header.Del(name)
+			}
+		}
+	}
+	for _, name := range hopByHopHeaders {
+		header.Del(name)
+	}
+}
+
+// UpgradeType returns the protocol the request or response with header is upgrading to, i.e. websocket,
+// or an empty string if it isn't an upgrade
+func UpgradeType(header http.Header) string {
+	for _, value := range header.Values("Connection") {
+		for _, token := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(token), "upgrade") {
+				return header.Get("Upgrade")
+			}
+		}
+	}
+	return ""
+}
+
+// WriteSwitchingProtocols writes a 101 response with header to w, the connection is handed over to the
+// protocol being upgraded to once it's written
+func WriteSwitchingProtocols(w io.Writer, header http.Header) error {
+	if _, err := io.WriteString(w, "HTTP/1.1 101 Switching Protocols\r\n"); err != nil {
+		return err
+	}
+	if err := header.Write(w); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\r\n")
+	return err
+}
@@ -0,0 +1,45 @@
+package http_helper
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoveHopByHopHeaders(t *testing.T) {
+	header := http.Header{
+		"Connection":        {"keep-alive, X-Internal"},
+		"Keep-Alive":        {"timeout=5"},
+		"Transfer-Encoding": {"chunked"},
+		"Upgrade":           {"websocket"},
+		"X-Internal":        {"secret"},
+		"Content-Type":      {"application/json"},
+		"X-Request-Id":      {"abc"},
+	}
+
+	RemoveHopByHopHeaders(header)
+
+	assert.Equal(t, http.Header{
+		"Content-Type": {"application/json"},
+		"X-Request-Id": {"abc"},
+	}, header)
+}
+
+func TestUpgradeType(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   http.Header
+		expected string
+	}{
+		{"WebSocket", http.Header{"Connection": {"Upgrade"}, "Upgrade": {"websocket"}}, "websocket"},
+		{"Token list", http.Header{"Connection": {"keep-alive, upgrade"}, "Upgrade": {"h2c"}}, "h2c"},
+		{"Upgrade without connection", http.Header{"Upgrade": {"websocket"}}, ""},
+		{"Not an upgrade", http.Header{"Connection": {"keep-alive"}}, ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, UpgradeType(test.header))
+		})
+	}
+}
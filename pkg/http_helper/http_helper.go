@@ -5,9 +5,8 @@ import (
 )
 
 /*
- * Right now this is a fairly basic struct used just for encoding.
- * Will have to see how this behaves with streaming, multi-part uploads etc.
- * For now, just testing with basic GET, PUT, UPDATE, DELETE requests
+ * Requests and responses can be encoded as JSON, which holds the whole body in memory, or with the binary
+ * encoding which streams the body in frames and so copes with large uploads and server-sent events.
  * It's also worth recognizing that this will just be limited to HTTP requests,
  * however, it'll be worth considering how this would work across other transport
  * layers such as WebSockets, gRPC, etc.
@@ -20,8 +19,9 @@ type HttpRequest struct {
 	Method  string      `json:"method"`
 	URL     string      `json:"url"`
 	Headers http.Header `json:"headers"`
-	// TODO: This is going to be limited if we want any sort of streaming but it should be okay for initial approach
-	Body []byte `json:"body"`
+	// Body is only used by the JSON encoding, the binary encoding streams the body, see Encoder
+	Body     []byte      `json:"body"`
+	Trailers http.Header `json:"trailers,omitempty"`
 }
 
 func NewHttpRequest(method string, url string, headers http.Header, body []byte) *HttpRequest {
@@ -32,3 +32,20 @@ func NewHttpRequest(method string, url string, headers http.Header, body []byte)
 		Body:    body,
 	}
 }
+
+// HttpResponse is the response to an HttpRequest
+type HttpResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Headers    http.Header `json:"headers"`
+	// Body is only used by the JSON encoding, the binary encoding streams the body, see Encoder
+	Body     []byte      `json:"body"`
+	Trailers http.Header `json:"trailers,omitempty"`
+}
+
+func NewHttpResponse(statusCode int, headers http.Header, body []byte) *HttpResponse {
+	return &HttpResponse{
+		StatusCode: statusCode,
+		Headers:    headers,
+		Body:       body,
+	}
+}
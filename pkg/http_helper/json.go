@@ -22,3 +22,17 @@ func FromJSON(jsonData []byte) (*HttpRequest, error) {
 	}
 	return &req, nil
 }
+
+// Serialize the HttpResponse struct to JSON
+func (resp *HttpResponse) ToJSON() ([]byte, error) {
+	return json.Marshal(resp)
+}
+
+// ResponseFromJSON deserializes a JSON byte slice into an HttpResponse struct
+func ResponseFromJSON(jsonData []byte) (*HttpResponse, error) {
+	var resp HttpResponse
+	if err := json.Unmarshal(jsonData, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,37 @@
+package streaming_connection
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidAuthToken = errors.New("invalid auth token")
+)
+
+// AgentIdentity is who an agent authenticated as during the handshake
+type AgentIdentity struct {
+	UserID string
+	// OrganizationID is the organization the agent is acting for, empty if it's only acting for the user
+	OrganizationID string
+}
+
+// Owner returns the key the hostnames claimed by the identity are held under. Hostnames belong to the
+// organization when there is one so that every member of it can serve them.
+func (i *AgentIdentity) Owner() string {
+	if i.OrganizationID != "" {
+		return OrganizationOwner(i.OrganizationID)
+	}
+	return fmt.Sprintf("user:%s", i.UserID)
+}
+
+// OrganizationOwner returns the key the hostnames claimed by the agents of an organization are held under
+func OrganizationOwner(organizationID string) string {
+	return fmt.Sprintf("organization:%s", organizationID)
+}
+
+// Authenticator checks the auth token presented by an agent in its Hello
+type Authenticator interface {
+	// Authenticate returns the identity token belongs to or ErrInvalidAuthToken if it isn't valid
+	Authenticate(token string) (*AgentIdentity, error)
+}
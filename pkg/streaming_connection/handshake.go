@@ -0,0 +1,272 @@
+package streaming_connection
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"slices"
+	"time"
+)
+
+/*
+ * Before a connection is multiplexed the agent and server exchange a handshake. The agent sends a Hello
+ * describing itself and the tunnels it wants, and the server answers with a HelloReply accepting or
+ * rejecting it. Each message is written as a 4 byte big endian length followed by that many bytes of JSON.
+ **/
+
+const (
+	// ProtocolVersion is the version of the handshake and framing protocol spoken by this package
+	ProtocolVersion = 1
+
+	// MaxHandshakeMessageSize is the largest handshake message that will be read
+	MaxHandshakeMessageSize = 64 * 1024
+
+	DefaultHandshakeTimeout = 10 * time.Second
+)
+
+const (
+	// CapabilityMultiplexing means the agent frames the connection with a MuxSession once the handshake is complete
+	CapabilityMultiplexing = "mux"
+	// CapabilityStreamHeaders means the agent expects every stream opened by the server to start with a
+	// StreamHeader, which lets it serve several tunnels over the one connection. The server confirms it by
+	// returning the capability in its HelloReply.
+	CapabilityStreamHeaders = "stream-headers"
+)
+
+const (
+	TunnelProtocolHttp = "http"
+	// TunnelProtocolTcp tunnels raw TCP connections made to a public port allocated by the server
+	TunnelProtocolTcp = "tcp"
+	// TunnelProtocolTls tunnels TLS connections made to the passthrough listener, routed by the server name
+	// of their ClientHello. The TLS session is between the client and the agent's service, the server never
+	// decrypts it.
+	TunnelProtocolTls = "tls"
+	// TunnelProtocolUdp tunnels UDP datagrams sent to a public port allocated by the server, see udp.go for how
+	// they're carried to the agent
+	TunnelProtocolUdp = "udp"
+)
+
+var (
+	ErrHandshakeTimeout            = errors.New("handshake timed out")
+	ErrHandshakeTooLarge           = errors.New("handshake message too large")
+	ErrHandshakeTruncated          = errors.New("handshake message truncated")
+	ErrHandshakeMalformed          = errors.New("handshake message malformed")
+	ErrUnsupportedProtocolVersion  = errors.New("unsupported protocol version")
+	ErrHandshakeMissingCapability  = errors.New("handshake missing required capability")
+	ErrHandshakeNoTunnelsRequested = errors.New("handshake requested no tunnels")
+)
+
+// HandshakeRejectedError is returned to the agent when the server rejects its Hello
+type HandshakeRejectedError struct {
+	Reason string
+}
+
+func (e *HandshakeRejectedError) Error() string {
+	return fmt.Sprintf("handshake rejected: %s", e.Reason)
+}
+
+// Hello is sent by the agent to open the connection
+type Hello struct {
+	ProtocolVersion int             `json:"protocolVersion"`
+	AgentVersion    string          `json:"agentVersion"`
+	AuthToken       string          `json:"authToken,omitempty"`
+	Tunnels         []TunnelRequest `json:"tunnels"`
+	Capabilities    []string        `json:"capabilities,omitempty"`
+	// MaxFrameSize is the largest frame the agent wants to send, the server may lower it
+	MaxFrameSize uint32 `json:"maxFrameSize,omitempty"`
+}
+
+// TunnelRequest describes a tunnel the agent wants to serve over the connection
+type TunnelRequest struct {
+	// Name identifies the tunnel to the agent, it is echoed back in the TunnelAssignment
+	Name     string `json:"name,omitempty"`
+	Protocol string `json:"protocol"`
+	// Hostname is the hostname the agent wants to serve, the server picks one when it's empty
+	Hostname string `json:"hostname"`
+	// RemotePort is the public port wanted by a TCP or UDP tunnel, the server picks one when it's 0
+	RemotePort int `json:"remotePort,omitempty"`
+	// LoadBalancingStrategy is used when several agents register the same hostname, an empty string
+	// keeps the strategy the hostname is already using
+	LoadBalancingStrategy string `json:"loadBalancingStrategy,omitempty"`
+}
+
+// HelloReply is sent by the server in response to a Hello
+type HelloReply struct {
+	Accepted bool               `json:"accepted"`
+	Reason   string             `json:"reason,omitempty"`
+	Tunnels  []TunnelAssignment `json:"tunnels,omitempty"`
+	Settings SessionSettings    `json:"settings"`
+	// Capabilities are the capabilities advertised by the agent that the server will use
+	Capabilities []string `json:"capabilities,omitempty"`
+}
+
+// TunnelAssignment is the server's answer to a TunnelRequest
+type TunnelAssignment struct {
+	Name      string `json:"name,omitempty"`
+	Hostname  string `json:"hostname"`
+	PublicURL string `json:"publicUrl"`
+	// RemotePort is the public port allocated to a TCP or UDP tunnel
+	RemotePort int `json:"remotePort,omitempty"`
+}
+
+// SessionSettings are negotiated during the handshake and apply to the connection once it's multiplexed
+type SessionSettings struct {
+	HeartbeatIntervalMs int64  `json:"heartbeatIntervalMs"`
+	MaxFrameSize        uint32 `json:"maxFrameSize"`
+}
+
+// HeartbeatInterval returns the negotiated heartbeat interval
+func (s SessionSettings) HeartbeatInterval() time.Duration {
+	return time.Duration(s.HeartbeatIntervalMs) * time.Millisecond
+}
+
+// MuxConfig returns the configuration for a MuxSession using the negotiated settings
+func (s SessionSettings) MuxConfig() *MuxConfig {
+	config := DefaultMuxConfig()
+	if s.MaxFrameSize > 0 {
+		config.MaxFrameSize = s.MaxFrameSize
+	}
+	config.KeepAliveInterval = s.HeartbeatInterval()
+	return config
+}
+
+// NewHello builds a Hello for the current protocol version
+func NewHello(agentVersion string, authToken string, tunnels []TunnelRequest) *Hello {
+	return &Hello{
+		ProtocolVersion: ProtocolVersion,
+		AgentVersion:    agentVersion,
+		AuthToken:       authToken,
+		Tunnels:         tunnels,
+		Capabilities:    []string{CapabilityMultiplexing, CapabilityStreamHeaders},
+	}
+}
+
+// HasCapability checks if the agent advertised capability
+func (h *Hello) HasCapability(capability string) bool {
+	return slices.Contains(h.Capabilities, capability)
+}
+
+// Validate checks that the Hello can be served by this version of the protocol
+func (h *Hello) Validate() error {
+	if h.ProtocolVersion != ProtocolVersion {
+		return fmt.Errorf("%w: %d", ErrUnsupportedProtocolVersion, h.ProtocolVersion)
+	}
+	if !h.HasCapability(CapabilityMultiplexing) {
+		return fmt.Errorf("%w: %s", ErrHandshakeMissingCapability, CapabilityMultiplexing)
+	}
+	if len(h.Tunnels) == 0 {
+		return ErrHandshakeNoTunnelsRequested
+	}
+	return nil
+}
+
+// HasCapability checks if the server will use capability
+func (r *HelloReply) HasCapability(capability string) bool {
+	return slices.Contains(r.Capabilities, capability)
+}
+
+// NewRejectedHelloReply builds a HelloReply rejecting the agent for reason
+func NewRejectedHelloReply(reason string) *HelloReply {
+	return &HelloReply{
+		Accepted: false,
+		Reason:   reason,
+	}
+}
+
+// ClientHandshake sends hello over conn and waits for the server to reply. If the server rejects the
+// hello a *HandshakeRejectedError is returned.
+func ClientHandshake(conn net.Conn, hello *Hello, timeout time.Duration) (*HelloReply, error) {
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+	defer conn.SetDeadline(time.Time{})
+
+	if err := writeHandshakeMessage(conn, hello); err != nil {
+		return nil, err
+	}
+	var reply HelloReply
+	if err := readHandshakeMessage(conn, &reply); err != nil {
+		return nil, err
+	}
+	if !reply.Accepted {
+		return nil, &HandshakeRejectedError{Reason: reply.Reason}
+	}
+	return &reply, nil
+}
+
+// ReadHello reads the Hello sent by an agent at the start of conn
+func ReadHello(conn net.Conn, timeout time.Duration) (*Hello, error) {
+	if err := conn.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+	defer conn.SetReadDeadline(time.Time{})
+
+	var hello Hello
+	if err := readHandshakeMessage(conn, &hello); err != nil {
+		return nil, err
+	}
+	return &hello, nil
+}
+
+// WriteHelloReply writes the server's reply to an agent's Hello
+func WriteHelloReply(conn net.Conn, reply *HelloReply, timeout time.Duration) error {
+	if err := conn.SetWriteDeadline(time.Now().Add(timeout)); err != nil {
+		return err
+	}
+	defer conn.SetWriteDeadline(time.Time{})
+
+	return writeHandshakeMessage(conn, reply)
+}
+
+func writeHandshakeMessage(w io.Writer, message any) error {
+	b, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	if len(b) > MaxHandshakeMessageSize {
+		return fmt.Errorf("%w: %d bytes", ErrHandshakeTooLarge, len(b))
+	}
+
+	buf := make([]byte, 4+len(b))
+	binary.BigEndian.PutUint32(buf, uint32(len(b)))
+	copy(buf[4:], b)
+	if _, err := w.Write(buf); err != nil {
+		return handshakeError(err)
+	}
+	return nil
+}
+
+func readHandshakeMessage(r io.Reader, message any) error {
+	var size uint32
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return handshakeError(err)
+	}
+	if size > MaxHandshakeMessageSize {
+		return fmt.Errorf("%w: %d bytes", ErrHandshakeTooLarge, size)
+	}
+
+	b := make([]byte, size)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return handshakeError(err)
+	}
+	if err := json.Unmarshal(b, message); err != nil {
+		return fmt.Errorf("%w: %v", ErrHandshakeMalformed, err)
+	}
+	return nil
+}
+
+// handshakeError maps errors from the connection to the typed handshake errors
+func handshakeError(err error) error {
+	switch {
+	case errors.Is(err, os.ErrDeadlineExceeded):
+		return ErrHandshakeTimeout
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return ErrHandshakeTruncated
+	default:
+		return err
+	}
+}
@@ -0,0 +1,155 @@
+package streaming_connection
+
+import (
+	"encoding/binary"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeRaw writes b to conn without blocking the test on net.Pipe's synchronous writes
+func writeRaw(conn net.Conn, b []byte) {
+	go conn.Write(b)
+}
+
+func lengthPrefixed(size uint32, body []byte) []byte {
+	buf := make([]byte, 4, 4+len(body))
+	binary.BigEndian.PutUint32(buf, size)
+	return append(buf, body...)
+}
+
+func TestHandshakeRoundTrip(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	hello := NewHello("1.2.3", "token", []TunnelRequest{{Name: "web", Protocol: TunnelProtocolHttp, Hostname: "foo"}})
+	hello.MaxFrameSize = 1024
+
+	go func() {
+		received, err := ReadHello(serverConn, time.Second)
+		if err != nil {
+			return
+		}
+		WriteHelloReply(serverConn, &HelloReply{
+			Accepted: true,
+			Tunnels:  []TunnelAssignment{{Name: received.Tunnels[0].Name, Hostname: "foo", PublicURL: "http://foo.yuka.dev"}},
+			Settings: SessionSettings{HeartbeatIntervalMs: 5000, MaxFrameSize: received.MaxFrameSize},
+		}, time.Second)
+	}()
+
+	reply, err := ClientHandshake(clientConn, hello, time.Second)
+	require.NoError(t, err)
+	assert.True(t, reply.Accepted)
+	assert.Equal(t, "http://foo.yuka.dev", reply.Tunnels[0].PublicURL)
+	assert.Equal(t, "web", reply.Tunnels[0].Name)
+	assert.Equal(t, 5*time.Second, reply.Settings.HeartbeatInterval())
+	assert.Equal(t, uint32(1024), reply.Settings.MuxConfig().MaxFrameSize)
+}
+
+func TestHandshakeRejected(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	go func() {
+		if _, err := ReadHello(serverConn, time.Second); err != nil {
+			return
+		}
+		WriteHelloReply(serverConn, NewRejectedHelloReply("not allowed"), time.Second)
+	}()
+
+	_, err := ClientHandshake(clientConn, NewHello("1.2.3", "", nil), time.Second)
+	var rejected *HandshakeRejectedError
+	require.ErrorAs(t, err, &rejected)
+	assert.Equal(t, "not allowed", rejected.Reason)
+}
+
+func TestReadHelloMalformed(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	body := []byte(`{"protocolVersion": "one"`)
+	writeRaw(clientConn, lengthPrefixed(uint32(len(body)), body))
+
+	_, err := ReadHello(serverConn, time.Second)
+	assert.ErrorIs(t, err, ErrHandshakeMalformed)
+}
+
+func TestReadHelloOversized(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	writeRaw(clientConn, lengthPrefixed(MaxHandshakeMessageSize+1, nil))
+
+	_, err := ReadHello(serverConn, time.Second)
+	assert.ErrorIs(t, err, ErrHandshakeTooLarge)
+}
+
+func TestWriteHelloOversized(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	hello := NewHello(strings.Repeat("a", MaxHandshakeMessageSize), "", nil)
+	_, err := ClientHandshake(clientConn, hello, time.Second)
+	assert.ErrorIs(t, err, ErrHandshakeTooLarge)
+}
+
+func TestReadHelloTruncated(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer serverConn.Close()
+
+	// Claim a larger body than is sent before the connection closes
+	go func() {
+		clientConn.Write(lengthPrefixed(100, []byte(`{"protocolVersion":1`)))
+		clientConn.Close()
+	}()
+
+	_, err := ReadHello(serverConn, time.Second)
+	assert.ErrorIs(t, err, ErrHandshakeTruncated)
+}
+
+func TestReadHelloTruncatedLength(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer serverConn.Close()
+
+	go func() {
+		clientConn.Write([]byte{0, 0})
+		clientConn.Close()
+	}()
+
+	_, err := ReadHello(serverConn, time.Second)
+	assert.ErrorIs(t, err, ErrHandshakeTruncated)
+}
+
+func TestReadHelloTimeout(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	_, err := ReadHello(serverConn, 20*time.Millisecond)
+	assert.ErrorIs(t, err, ErrHandshakeTimeout)
+}
+
+func TestHelloValidate(t *testing.T) {
+	tunnels := []TunnelRequest{{Protocol: TunnelProtocolHttp, Hostname: "foo"}}
+
+	assert.NoError(t, NewHello("1.2.3", "", tunnels).Validate())
+
+	unsupported := NewHello("1.2.3", "", tunnels)
+	unsupported.ProtocolVersion = ProtocolVersion + 1
+	assert.ErrorIs(t, unsupported.Validate(), ErrUnsupportedProtocolVersion)
+
+	noMux := NewHello("1.2.3", "", tunnels)
+	noMux.Capabilities = nil
+	assert.ErrorIs(t, noMux.Validate(), ErrHandshakeMissingCapability)
+
+	assert.ErrorIs(t, NewHello("1.2.3", "", nil).Validate(), ErrHandshakeNoTunnelsRequested)
+}
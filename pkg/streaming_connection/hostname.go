@@ -0,0 +1,49 @@
+package streaming_connection
+
+import (
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"yuka/pkg/utils"
+)
+
+var ErrInvalidHostname = errors.New("invalid hostname")
+
+// CanonicalHostname returns the single name host is registered under in the pool. The port and trailing dot
+// are dropped and subdomains of publicHost are reduced to their label, i.e "FOO.yuka.dev:8081" becomes "foo"
+// for the public host "yuka.dev". Any other name is a custom domain and is kept as is. ErrInvalidHostname is
+// returned for hosts that aren't DNS names, the public host itself and nested subdomains of it.
+func CanonicalHostname(host string, publicHost string) (string, error) {
+	if h, port, err := net.SplitHostPort(host); err == nil {
+		if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			return "", ErrInvalidHostname
+		}
+		host = h
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if !utils.IsSubdomain(host) {
+		return "", ErrInvalidHostname
+	}
+
+	publicHostname := strings.ToLower(publicHost)
+	if h, _, err := net.SplitHostPort(publicHostname); err == nil {
+		publicHostname = h
+	}
+	if host == publicHostname {
+		return "", ErrInvalidHostname
+	}
+	if subdomain, ok := strings.CutSuffix(host, "."+publicHostname); ok {
+		if IsCustomDomain(subdomain) {
+			return "", ErrInvalidHostname
+		}
+		return subdomain, nil
+	}
+	return host, nil
+}
+
+// IsCustomDomain returns true if the canonical hostname is a domain of its own rather than a subdomain of the
+// public host
+func IsCustomDomain(hostname string) bool {
+	return strings.Contains(hostname, ".")
+}
@@ -0,0 +1,91 @@
+package streaming_connection
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net"
+	"strconv"
+)
+
+// LoadBalancingStrategy decides which connection a request is sent to when several agents have
+// registered the same hostname
+type LoadBalancingStrategy string
+
+const (
+	// LoadBalancingRoundRobin cycles through each connection in turn
+	LoadBalancingRoundRobin LoadBalancingStrategy = "round-robin"
+	// LoadBalancingLeastInFlight picks the connection with the fewest open streams
+	LoadBalancingLeastInFlight LoadBalancingStrategy = "least-in-flight"
+	// LoadBalancingConsistentHash always sends a client IP to the same connection while it remains registered
+	LoadBalancingConsistentHash LoadBalancingStrategy = "consistent-hash"
+
+	DefaultLoadBalancingStrategy = LoadBalancingRoundRobin
+)
+
+// ParseLoadBalancingStrategy validates s is a known strategy. An empty string returns the default strategy.
+func ParseLoadBalancingStrategy(s string) (LoadBalancingStrategy, error) {
+	switch strategy := LoadBalancingStrategy(s); strategy {
+	case "":
+		return DefaultLoadBalancingStrategy, nil
+	case LoadBalancingRoundRobin, LoadBalancingLeastInFlight, LoadBalancingConsistentHash:
+		return strategy, nil
+	default:
+		return "", fmt.Errorf("unknown load balancing strategy %q", s)
+	}
+}
+
+// selectEntry picks an entry using strategy. Draining entries are never picked, nil is returned if there's
+// no entry available.
+func selectEntry(strategy LoadBalancingStrategy, entries []*poolEntry, counter uint64, clientAddr string) *poolEntry {
+	available := make([]*poolEntry, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.conn.IsDraining() && entry.conn.IsOpen() {
+			available = append(available, entry)
+		}
+	}
+	if len(available) == 0 {
+		return nil
+	}
+
+	switch strategy {
+	case LoadBalancingLeastInFlight:
+		selected := available[0]
+		for _, entry := range available[1:] {
+			if entry.conn.NumStreams() < selected.conn.NumStreams() {
+				selected = entry
+			}
+		}
+		return selected
+	case LoadBalancingConsistentHash:
+		if clientAddr == "" {
+			break
+		}
+		return rendezvousHash(available, clientIP(clientAddr))
+	}
+	return available[counter%uint64(len(available))]
+}
+
+// rendezvousHash picks the entry with the highest hash of key and entry id. Adding or removing an entry only
+// moves the keys that hash to that entry, the rest keep going to the same place.
+func rendezvousHash(entries []*poolEntry, key string) *poolEntry {
+	var selected *poolEntry
+	var highest uint64
+	for _, entry := range entries {
+		h := fnv.New64a()
+		h.Write([]byte(key))
+		h.Write([]byte(strconv.FormatUint(entry.id, 10)))
+		if score := h.Sum64(); selected == nil || score > highest {
+			selected = entry
+			highest = score
+		}
+	}
+	return selected
+}
+
+// clientIP strips the port from addr if it has one
+func clientIP(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
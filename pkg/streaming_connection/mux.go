@@ -0,0 +1,536 @@
+package streaming_connection
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var (
+	ErrSessionShutdown = errors.New("session shutdown")
+	ErrStreamClosed    = errors.New("stream closed")
+	ErrStreamReset     = errors.New("stream reset")
+	ErrProtocol        = errors.New("protocol error")
+	ErrRemoteGoAway    = errors.New("remote end is not accepting new streams")
+	ErrPingTimeout     = errors.New("timed out waiting for pong")
+	ErrKeepAliveFailed = errors.New("remote end missed too many heartbeats")
+)
+
+// MuxConfig configures a MuxSession
+type MuxConfig struct {
+	// AcceptBacklog is the number of streams opened by the remote end that can be waiting to be accepted.
+	// Once the backlog is full any new streams are reset.
+	AcceptBacklog int
+
+	// MaxFrameSize is the largest payload a single data frame will carry. Large writes are split over
+	// multiple frames so that one stream can't starve the others.
+	MaxFrameSize uint32
+
+	// KeepAliveInterval is how often the session pings the remote end. Zero disables keep alives.
+	KeepAliveInterval time.Duration
+
+	// MaxMissedHeartbeats is the number of pings in a row that can go unanswered before the session is
+	// closed. This catches half-open connections where writes succeed but nothing is ever read.
+	MaxMissedHeartbeats int
+}
+
+// DefaultMuxConfig returns the configuration used when none is given to NewMuxSession
+func DefaultMuxConfig() *MuxConfig {
+	return &MuxConfig{
+		AcceptBacklog:       256,
+		MaxFrameSize:        32 * 1024,
+		MaxMissedHeartbeats: 3,
+	}
+}
+
+// MuxSession multiplexes many logical streams over a single connection. Either end of the session
+// can open streams which the other end accepts, each stream having its own flow control window so a
+// slow reader on one stream doesn't block the others.
+type MuxSession struct {
+	slogger  *zap.SugaredLogger
+	conn     net.Conn
+	config   *MuxConfig
+	isClient bool
+
+	streamLock   sync.Mutex
+	nextStreamID uint32
+	streams      map[uint32]*MuxStream
+
+	acceptCh chan *MuxStream
+
+	// remoteGoAway is set once the remote end has announced it is shutting down
+	remoteGoAway atomic.Bool
+
+	pingLock   sync.Mutex
+	pings      map[uint32]chan struct{}
+	nextPingID uint32
+	// latency is the round trip time of the last answered ping
+	latency atomic.Int64
+
+	// sendCh is used for data frames where the writer waits on the result
+	sendCh chan *muxSendReady
+	// controlQueue is used for control frames sent from the receive loop, these must never block
+	// otherwise two peers with full buffers could deadlock waiting on each other
+	controlLock   sync.Mutex
+	controlQueue  []muxHeader
+	controlNotify chan struct{}
+
+	shutdownLock sync.Mutex
+	shutdown     bool
+	shutdownErr  error
+	shutdownCh   chan struct{}
+}
+
+type muxSendReady struct {
+	hdr   muxHeader
+	body  []byte
+	errCh chan error
+
+	// lock is held while the frame is written so a writer can't give up on it while its body is being read
+	lock      sync.Mutex
+	written   bool
+	cancelled bool
+}
+
+// cancel stops the frame from being written, false if it already has been
+func (r *muxSendReady) cancel() bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.written {
+		return false
+	}
+	r.cancelled = true
+	return true
+}
+
+// NewMuxSession starts a session over conn. Clients allocate odd stream ids and servers even ones
+// so both ends can open streams without coordinating. If config is nil DefaultMuxConfig is used.
+func NewMuxSession(logger *zap.Logger, conn net.Conn, isClient bool, config *MuxConfig) *MuxSession {
+	if config == nil {
+		config = DefaultMuxConfig()
+	}
+	session := &MuxSession{
+		slogger:       logger.Sugar(),
+		conn:          conn,
+		config:        config,
+		isClient:      isClient,
+		streams:       make(map[uint32]*MuxStream),
+		pings:         make(map[uint32]chan struct{}),
+		acceptCh:      make(chan *MuxStream, config.AcceptBacklog),
+		sendCh:        make(chan *muxSendReady, 64),
+		controlNotify: make(chan struct{}, 1),
+		shutdownCh:    make(chan struct{}),
+	}
+	if isClient {
+		session.nextStreamID = 1
+	} else {
+		session.nextStreamID = 2
+	}
+	go session.recvLoop()
+	go session.sendLoop()
+	if config.KeepAliveInterval > 0 {
+		go session.keepAlive()
+	}
+	return session
+}
+
+// OpenStream opens a new stream to the remote end of the session
+func (s *MuxSession) OpenStream() (Stream, error) {
+	if s.IsClosed() {
+		return nil, ErrSessionShutdown
+	}
+	if s.remoteGoAway.Load() {
+		return nil, ErrRemoteGoAway
+	}
+
+	s.streamLock.Lock()
+	id := s.nextStreamID
+	s.nextStreamID += 2
+	stream := newMuxStream(s, id)
+	s.streams[id] = stream
+	s.streamLock.Unlock()
+
+	if err := s.waitForSend(newMuxHeader(muxFrameWindowUpdate, muxFlagSYN, id, 0), nil, nil, nil); err != nil {
+		s.closeStream(id)
+		return nil, err
+	}
+	return stream, nil
+}
+
+// AcceptStream blocks until the remote end opens a stream or the session is closed
+func (s *MuxSession) AcceptStream() (Stream, error) {
+	select {
+	case stream := <-s.acceptCh:
+		return stream, nil
+	case <-s.shutdownCh:
+		return nil, s.shutdownError()
+	}
+}
+
+// GoAway tells the remote end that the session is shutting down so it should stop opening new streams.
+// Existing streams are unaffected, allowing them to finish before the session is closed.
+func (s *MuxSession) GoAway() error {
+	return s.waitForSend(newMuxHeader(muxFrameGoAway, 0, 0, 0), nil, nil, nil)
+}
+
+// Ping sends a ping to the remote end and waits up to timeout for its pong, returning the round trip time
+func (s *MuxSession) Ping(timeout time.Duration) (time.Duration, error) {
+	s.pingLock.Lock()
+	s.nextPingID++
+	id := s.nextPingID
+	pongCh := make(chan struct{})
+	s.pings[id] = pongCh
+	s.pingLock.Unlock()
+	defer func() {
+		s.pingLock.Lock()
+		delete(s.pings, id)
+		s.pingLock.Unlock()
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	// Pings go through the control queue so a stream with a full window can't hold them up
+	start := time.Now()
+	s.queueControl(newMuxHeader(muxFramePing, muxFlagSYN, 0, id))
+	select {
+	case <-pongCh:
+		rtt := time.Since(start)
+		s.latency.Store(int64(rtt))
+		return rtt, nil
+	case <-timer.C:
+		return 0, ErrPingTimeout
+	case <-s.shutdownCh:
+		return 0, ErrSessionShutdown
+	}
+}
+
+// Latency returns the round trip time of the last answered ping, zero if no ping has been answered yet
+func (s *MuxSession) Latency() time.Duration {
+	return time.Duration(s.latency.Load())
+}
+
+// IsDraining checks if the remote end has announced that it is shutting down
+func (s *MuxSession) IsDraining() bool {
+	return s.remoteGoAway.Load()
+}
+
+// Close closes the session and all of its streams
+func (s *MuxSession) Close() error {
+	s.exitErr(ErrSessionShutdown)
+	return nil
+}
+
+// IsOpen checks if the session is still open
+func (s *MuxSession) IsOpen() bool {
+	return !s.IsClosed()
+}
+
+// IsClosed checks if the session has been closed, either locally or because the connection failed
+func (s *MuxSession) IsClosed() bool {
+	select {
+	case <-s.shutdownCh:
+		return true
+	default:
+		return false
+	}
+}
+
+// CloseChan returns a channel that is closed once the session is closed
+func (s *MuxSession) CloseChan() <-chan struct{} {
+	return s.shutdownCh
+}
+
+// NumStreams returns the number of streams currently open on the session
+func (s *MuxSession) NumStreams() int {
+	s.streamLock.Lock()
+	defer s.streamLock.Unlock()
+	return len(s.streams)
+}
+
+// RemoteAddr returns the address of the remote end of the underlying connection
+func (s *MuxSession) RemoteAddr() net.Addr {
+	return s.conn.RemoteAddr()
+}
+
+// LocalAddr returns the address of the local end of the underlying connection
+func (s *MuxSession) LocalAddr() net.Addr {
+	return s.conn.LocalAddr()
+}
+
+func (s *MuxSession) shutdownError() error {
+	s.shutdownLock.Lock()
+	defer s.shutdownLock.Unlock()
+	return s.shutdownErr
+}
+
+// exitErr shuts the session down recording err as the reason
+func (s *MuxSession) exitErr(err error) {
+	s.shutdownLock.Lock()
+	if s.shutdown {
+		s.shutdownLock.Unlock()
+		return
+	}
+	s.shutdown = true
+	s.shutdownErr = err
+	close(s.shutdownCh)
+	s.shutdownLock.Unlock()
+
+	// Any streams blocked reading or writing are woken up by shutdownCh being closed
+	s.conn.Close()
+}
+
+// waitForSend queues a frame to be written and waits until it has been written. If deadline isn't nil the
+// frame is dropped and os.ErrDeadlineExceeded returned once the time it returns has passed, it's called again
+// whenever wakeCh is signalled so the deadline can be moved while waiting. A frame that has started being
+// written is always waited for as its body can't be abandoned halfway.
+func (s *MuxSession) waitForSend(hdr muxHeader, body []byte, deadline func() time.Time, wakeCh <-chan struct{}) error {
+	ready := &muxSendReady{hdr: hdr, body: body, errCh: make(chan error, 1)}
+	sendCh := s.sendCh
+	for {
+		var timeout <-chan time.Time
+		var timer *time.Timer
+		if deadline != nil && !deadline().IsZero() {
+			delay := time.Until(deadline())
+			if delay <= 0 {
+				if ready.cancel() {
+					return os.ErrDeadlineExceeded
+				}
+				return <-ready.errCh
+			}
+			timer = time.NewTimer(delay)
+			timeout = timer.C
+		}
+
+		select {
+		case sendCh <- ready:
+			// A nil channel is never ready, the frame is queued
+			sendCh = nil
+		case err := <-ready.errCh:
+			return err
+		case <-wakeCh:
+		case <-timeout:
+		case <-s.shutdownCh:
+			return ErrSessionShutdown
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
+
+// queueControl queues a control frame to be written without waiting
+func (s *MuxSession) queueControl(hdr muxHeader) {
+	s.controlLock.Lock()
+	s.controlQueue = append(s.controlQueue, hdr)
+	s.controlLock.Unlock()
+	select {
+	case s.controlNotify <- struct{}{}:
+	default:
+	}
+}
+
+func (s *MuxSession) sendLoop() {
+	for {
+		// Control frames are always flushed first as they free up window for the remote end
+		s.controlLock.Lock()
+		control := s.controlQueue
+		s.controlQueue = nil
+		s.controlLock.Unlock()
+		for _, hdr := range control {
+			if _, err := s.conn.Write(hdr[:]); err != nil {
+				s.exitErr(fmt.Errorf("error writing frame: %v", err))
+				return
+			}
+		}
+
+		select {
+		case <-s.controlNotify:
+		case ready := <-s.sendCh:
+			ready.lock.Lock()
+			if ready.cancelled {
+				ready.lock.Unlock()
+				continue
+			}
+			err := s.writeFrame(ready.hdr, ready.body)
+			ready.written = true
+			ready.lock.Unlock()
+			ready.errCh <- err
+			if err != nil {
+				s.exitErr(err)
+				return
+			}
+		case <-s.shutdownCh:
+			return
+		}
+	}
+}
+
+func (s *MuxSession) writeFrame(hdr muxHeader, body []byte) error {
+	buffers := net.Buffers{hdr[:]}
+	if len(body) > 0 {
+		buffers = append(buffers, body)
+	}
+	if _, err := buffers.WriteTo(s.conn); err != nil {
+		return fmt.Errorf("error writing frame: %v", err)
+	}
+	return nil
+}
+
+func (s *MuxSession) recvLoop() {
+	var hdr muxHeader
+	for {
+		if _, err := io.ReadFull(s.conn, hdr[:]); err != nil {
+			if err != io.EOF && !s.IsClosed() {
+				s.slogger.Debugf("Error reading frame header: %v", err)
+			}
+			s.exitErr(err)
+			return
+		}
+		if hdr.Version() != muxProtocolVersion {
+			s.slogger.Warnf("Received frame with unsupported version %d", hdr.Version())
+			s.exitErr(ErrProtocol)
+			return
+		}
+
+		var err error
+		switch hdr.Type() {
+		case muxFrameData, muxFrameWindowUpdate:
+			err = s.handleStreamFrame(hdr)
+		case muxFrameGoAway:
+			s.slogger.Debugf("Remote end is going away")
+			s.remoteGoAway.Store(true)
+		case muxFramePing:
+			s.handlePing(hdr)
+		default:
+			s.slogger.Warnf("Received frame with unknown type %d", hdr.Type())
+			err = ErrProtocol
+		}
+		if err != nil {
+			s.exitErr(err)
+			return
+		}
+	}
+}
+
+func (s *MuxSession) handlePing(hdr muxHeader) {
+	id := hdr.Length()
+	if hdr.Flags()&muxFlagSYN != 0 {
+		s.queueControl(newMuxHeader(muxFramePing, muxFlagACK, 0, id))
+		return
+	}
+
+	s.pingLock.Lock()
+	pongCh, ok := s.pings[id]
+	if ok {
+		delete(s.pings, id)
+		close(pongCh)
+	}
+	s.pingLock.Unlock()
+}
+
+// keepAlive pings the remote end every KeepAliveInterval, closing the session once MaxMissedHeartbeats
+// pings in a row have gone unanswered
+func (s *MuxSession) keepAlive() {
+	ticker := time.NewTicker(s.config.KeepAliveInterval)
+	defer ticker.Stop()
+
+	missed := 0
+	for {
+		select {
+		case <-ticker.C:
+			_, err := s.Ping(s.config.KeepAliveInterval)
+			if err == nil {
+				missed = 0
+				continue
+			}
+			if s.IsClosed() {
+				return
+			}
+			missed++
+			s.slogger.Warnf("Missed heartbeat %d of %d from %s: %v", missed, s.config.MaxMissedHeartbeats, s.RemoteAddr(), err)
+			if missed >= s.config.MaxMissedHeartbeats {
+				s.exitErr(ErrKeepAliveFailed)
+				return
+			}
+		case <-s.shutdownCh:
+			return
+		}
+	}
+}
+
+func (s *MuxSession) handleStreamFrame(hdr muxHeader) error {
+	id := hdr.StreamID()
+	flags := hdr.Flags()
+
+	if flags&muxFlagSYN != 0 {
+		if err := s.incomingStream(id); err != nil {
+			return err
+		}
+	}
+
+	s.streamLock.Lock()
+	stream := s.streams[id]
+	s.streamLock.Unlock()
+
+	if stream == nil {
+		// The stream has already been closed locally so any data for it can be thrown away
+		if hdr.Type() == muxFrameData && hdr.Length() > 0 {
+			if _, err := io.CopyN(io.Discard, s.conn, int64(hdr.Length())); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if hdr.Type() == muxFrameData {
+		if err := stream.readData(hdr, s.conn); err != nil {
+			return err
+		}
+	} else {
+		stream.incrSendWindow(hdr.Length())
+	}
+	stream.processFlags(flags)
+	return nil
+}
+
+func (s *MuxSession) incomingStream(id uint32) error {
+	// Streams opened by the remote end always have the opposite parity to our own
+	if (id%2 == 1) == s.isClient {
+		s.slogger.Warnf("Received stream %d with invalid id", id)
+		return ErrProtocol
+	}
+
+	s.streamLock.Lock()
+	if _, ok := s.streams[id]; ok {
+		s.streamLock.Unlock()
+		s.slogger.Warnf("Received duplicate stream %d", id)
+		return ErrProtocol
+	}
+	stream := newMuxStream(s, id)
+	s.streams[id] = stream
+	s.streamLock.Unlock()
+
+	select {
+	case s.acceptCh <- stream:
+		s.queueControl(newMuxHeader(muxFrameWindowUpdate, muxFlagACK, id, 0))
+	default:
+		s.slogger.Warnf("Accept backlog is full, resetting stream %d", id)
+		s.closeStream(id)
+		s.queueControl(newMuxHeader(muxFrameWindowUpdate, muxFlagRST, id, 0))
+	}
+	return nil
+}
+
+func (s *MuxSession) closeStream(id uint32) {
+	s.streamLock.Lock()
+	delete(s.streams, id)
+	s.streamLock.Unlock()
+}
@@ -0,0 +1,89 @@
+package streaming_connection
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+/*
+ * Every frame sent over a multiplexed connection starts with a fixed size header:
+ *
+ *   | version (1) | type (1) | flags (2) | stream id (4) | length (4) |
+ *
+ * For data frames the length is the size of the payload that follows the header. For window
+ * updates it is the number of bytes the receiver is granting the sender on top of its current window.
+ * Go away and ping frames aren't tied to a stream and so always use stream id 0, pings carry an opaque
+ * id in the length which is echoed back in the pong.
+ **/
+
+const (
+	muxProtocolVersion uint8 = 0
+	muxHeaderSize            = 12
+
+	// muxInitialStreamWindow is the number of bytes that can be in flight on a stream before the
+	// receiver has to read them and grant more window
+	muxInitialStreamWindow uint32 = 256 * 1024
+)
+
+type muxFrameType uint8
+
+const (
+	// muxFrameData carries stream data, the length is the size of the payload
+	muxFrameData muxFrameType = iota
+	// muxFrameWindowUpdate grants the sender more window, the length is the window delta
+	muxFrameWindowUpdate
+	// muxFrameGoAway tells the remote end not to open any more streams as the session is shutting down
+	muxFrameGoAway
+	// muxFramePing is a ping when sent with SYN and its pong when sent with ACK, the length is the ping id
+	muxFramePing
+)
+
+type muxFlag uint16
+
+const (
+	// muxFlagSYN opens a new stream or sends a ping
+	muxFlagSYN muxFlag = 1 << iota
+	// muxFlagACK acknowledges a new stream or answers a ping
+	muxFlagACK
+	// muxFlagFIN half-closes the stream, the sender will not write any more data
+	muxFlagFIN
+	// muxFlagRST aborts the stream in both directions
+	muxFlagRST
+)
+
+type muxHeader [muxHeaderSize]byte
+
+func newMuxHeader(frameType muxFrameType, flags muxFlag, streamID uint32, length uint32) muxHeader {
+	var hdr muxHeader
+	hdr[0] = muxProtocolVersion
+	hdr[1] = uint8(frameType)
+	binary.BigEndian.PutUint16(hdr[2:4], uint16(flags))
+	binary.BigEndian.PutUint32(hdr[4:8], streamID)
+	binary.BigEndian.PutUint32(hdr[8:12], length)
+	return hdr
+}
+
+func (h muxHeader) Version() uint8 {
+	return h[0]
+}
+
+func (h muxHeader) Type() muxFrameType {
+	return muxFrameType(h[1])
+}
+
+func (h muxHeader) Flags() muxFlag {
+	return muxFlag(binary.BigEndian.Uint16(h[2:4]))
+}
+
+func (h muxHeader) StreamID() uint32 {
+	return binary.BigEndian.Uint32(h[4:8])
+}
+
+func (h muxHeader) Length() uint32 {
+	return binary.BigEndian.Uint32(h[8:12])
+}
+
+func (h muxHeader) String() string {
+	return fmt.Sprintf("version:%d type:%d flags:%d stream:%d length:%d",
+		h.Version(), h.Type(), h.Flags(), h.StreamID(), h.Length())
+}
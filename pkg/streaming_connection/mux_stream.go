@@ -0,0 +1,319 @@
+package streaming_connection
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// MuxStream is a single logical stream within a MuxSession and implements Stream
+type MuxStream struct {
+	id      uint32
+	session *MuxSession
+
+	stateLock    sync.Mutex
+	localClosed  bool
+	remoteClosed bool
+	reset        bool
+
+	recvLock   sync.Mutex
+	recvBuf    bytes.Buffer
+	recvWindow uint32
+
+	writeLock  sync.Mutex
+	sendWindow atomic.Uint32
+
+	recvNotifyCh chan struct{}
+	sendNotifyCh chan struct{}
+
+	readDeadline  atomic.Value
+	writeDeadline atomic.Value
+}
+
+func newMuxStream(session *MuxSession, id uint32) *MuxStream {
+	stream := &MuxStream{
+		id:           id,
+		session:      session,
+		recvWindow:   muxInitialStreamWindow,
+		recvNotifyCh: make(chan struct{}, 1),
+		sendNotifyCh: make(chan struct{}, 1),
+	}
+	stream.sendWindow.Store(muxInitialStreamWindow)
+	stream.readDeadline.Store(time.Time{})
+	stream.writeDeadline.Store(time.Time{})
+	return stream
+}
+
+// ID returns the id of the stream, unique within its session
+func (s *MuxStream) ID() uint32 {
+	return s.id
+}
+
+// Read reads data sent by the remote end of the stream. Returns io.EOF once the remote end has
+// half-closed the stream and all buffered data has been read.
+func (s *MuxStream) Read(b []byte) (int, error) {
+	for {
+		s.recvLock.Lock()
+		if s.recvBuf.Len() > 0 {
+			n, _ := s.recvBuf.Read(b)
+			s.recvLock.Unlock()
+			s.sendWindowUpdate()
+			return n, nil
+		}
+		s.recvLock.Unlock()
+
+		s.stateLock.Lock()
+		reset, remoteClosed := s.reset, s.remoteClosed
+		s.stateLock.Unlock()
+		switch {
+		case reset:
+			return 0, ErrStreamReset
+		case remoteClosed:
+			return 0, io.EOF
+		case s.session.IsClosed():
+			return 0, ErrSessionShutdown
+		}
+
+		if err := s.wait(s.recvNotifyCh, s.readDeadline.Load().(time.Time)); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// Write writes data to the stream, blocking while the remote end's receive window is exhausted or the
+// session is busy writing other frames. os.ErrDeadlineExceeded is returned once the write deadline passes.
+func (s *MuxStream) Write(b []byte) (int, error) {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	total := 0
+	for total < len(b) {
+		n, err := s.write(b[total:])
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+func (s *MuxStream) write(b []byte) (int, error) {
+	for {
+		s.stateLock.Lock()
+		localClosed, reset := s.localClosed, s.reset
+		s.stateLock.Unlock()
+		switch {
+		case reset:
+			return 0, ErrStreamReset
+		case localClosed:
+			return 0, ErrStreamClosed
+		case s.session.IsClosed():
+			return 0, ErrSessionShutdown
+		}
+
+		window := s.sendWindow.Load()
+		if window == 0 {
+			if err := s.wait(s.sendNotifyCh, s.getWriteDeadline()); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		size := min(window, uint32(len(b)), s.session.config.MaxFrameSize)
+		if err := s.session.waitForSend(newMuxHeader(muxFrameData, 0, s.id, size), b[:size], s.getWriteDeadline, s.sendNotifyCh); err != nil {
+			return 0, err
+		}
+		s.sendWindow.Add(^(size - 1))
+		return int(size), nil
+	}
+}
+
+// wait blocks until notified, the deadline passes or the session shuts down
+func (s *MuxStream) wait(notifyCh chan struct{}, deadline time.Time) error {
+	var timeout <-chan time.Time
+	if !deadline.IsZero() {
+		delay := time.Until(deadline)
+		if delay <= 0 {
+			return os.ErrDeadlineExceeded
+		}
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case <-notifyCh:
+		return nil
+	case <-timeout:
+		return os.ErrDeadlineExceeded
+	case <-s.session.shutdownCh:
+		return nil
+	}
+}
+
+// CloseWrite half-closes the stream. The remote end reads io.EOF once it has read all data
+// written so far, but can continue to write to the stream until it closes it as well.
+func (s *MuxStream) CloseWrite() error {
+	s.stateLock.Lock()
+	if s.localClosed || s.reset {
+		s.stateLock.Unlock()
+		return nil
+	}
+	s.localClosed = true
+	fullyClosed := s.remoteClosed
+	s.stateLock.Unlock()
+	s.notify(s.sendNotifyCh)
+
+	err := s.session.waitForSend(newMuxHeader(muxFrameWindowUpdate, muxFlagFIN, s.id, 0), nil, nil, nil)
+	if fullyClosed {
+		s.session.closeStream(s.id)
+	}
+	return err
+}
+
+// Close closes the stream. If the remote end has already finished writing this is a graceful close,
+// otherwise the stream is reset as nothing will be reading what it sends.
+func (s *MuxStream) Close() error {
+	s.stateLock.Lock()
+	done := s.reset || (s.localClosed && s.remoteClosed) || s.session.IsClosed()
+	remoteClosed := s.remoteClosed
+	s.stateLock.Unlock()
+
+	if done {
+		return nil
+	}
+	if remoteClosed {
+		return s.CloseWrite()
+	}
+	return s.Reset()
+}
+
+// Reset aborts the stream in both directions
+func (s *MuxStream) Reset() error {
+	s.stateLock.Lock()
+	if s.reset || s.session.IsClosed() {
+		s.stateLock.Unlock()
+		return nil
+	}
+	s.reset = true
+	s.stateLock.Unlock()
+
+	s.session.closeStream(s.id)
+	s.session.queueControl(newMuxHeader(muxFrameWindowUpdate, muxFlagRST, s.id, 0))
+	s.notify(s.recvNotifyCh)
+	s.notify(s.sendNotifyCh)
+	return nil
+}
+
+// LocalAddr returns the local address of the session the stream belongs to
+func (s *MuxStream) LocalAddr() net.Addr {
+	return s.session.LocalAddr()
+}
+
+// RemoteAddr returns the remote address of the session the stream belongs to
+func (s *MuxStream) RemoteAddr() net.Addr {
+	return s.session.RemoteAddr()
+}
+
+// SetDeadline sets both the read and write deadlines
+func (s *MuxStream) SetDeadline(t time.Time) error {
+	if err := s.SetReadDeadline(t); err != nil {
+		return err
+	}
+	return s.SetWriteDeadline(t)
+}
+
+// SetReadDeadline sets the deadline for pending and future reads
+func (s *MuxStream) SetReadDeadline(t time.Time) error {
+	s.readDeadline.Store(t)
+	s.notify(s.recvNotifyCh)
+	return nil
+}
+
+// SetWriteDeadline sets the deadline for pending and future writes
+func (s *MuxStream) SetWriteDeadline(t time.Time) error {
+	s.writeDeadline.Store(t)
+	s.notify(s.sendNotifyCh)
+	return nil
+}
+
+func (s *MuxStream) getWriteDeadline() time.Time {
+	return s.writeDeadline.Load().(time.Time)
+}
+
+// readData reads the payload of a data frame into the receive buffer
+func (s *MuxStream) readData(hdr muxHeader, conn io.Reader) error {
+	length := hdr.Length()
+	if length == 0 {
+		return nil
+	}
+
+	s.recvLock.Lock()
+	if length > s.recvWindow {
+		s.recvLock.Unlock()
+		s.session.slogger.Warnf("Stream %d exceeded its receive window", s.id)
+		return ErrProtocol
+	}
+	_, err := io.CopyN(&s.recvBuf, conn, int64(length))
+	s.recvWindow -= length
+	s.recvLock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	s.notify(s.recvNotifyCh)
+	return nil
+}
+
+// sendWindowUpdate grants the remote end more window once enough of the receive buffer has been read
+func (s *MuxStream) sendWindowUpdate() {
+	s.recvLock.Lock()
+	delta := muxInitialStreamWindow - uint32(s.recvBuf.Len()) - s.recvWindow
+	if delta < muxInitialStreamWindow/2 {
+		s.recvLock.Unlock()
+		return
+	}
+	s.recvWindow += delta
+	s.recvLock.Unlock()
+
+	s.session.queueControl(newMuxHeader(muxFrameWindowUpdate, 0, s.id, delta))
+}
+
+func (s *MuxStream) incrSendWindow(delta uint32) {
+	if delta == 0 {
+		return
+	}
+	s.sendWindow.Add(delta)
+	s.notify(s.sendNotifyCh)
+}
+
+func (s *MuxStream) processFlags(flags muxFlag) {
+	if flags&muxFlagFIN != 0 {
+		s.stateLock.Lock()
+		s.remoteClosed = true
+		fullyClosed := s.localClosed
+		s.stateLock.Unlock()
+		if fullyClosed {
+			s.session.closeStream(s.id)
+		}
+		s.notify(s.recvNotifyCh)
+	}
+	if flags&muxFlagRST != 0 {
+		s.stateLock.Lock()
+		s.reset = true
+		s.stateLock.Unlock()
+		s.session.closeStream(s.id)
+		s.notify(s.recvNotifyCh)
+		s.notify(s.sendNotifyCh)
+	}
+}
+
+func (s *MuxStream) notify(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,301 @@
+package streaming_connection
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestSessions(t *testing.T) (*MuxSession, *MuxSession) {
+	clientConn, serverConn := net.Pipe()
+	client := NewMuxSession(zap.NewNop(), clientConn, true, nil)
+	server := NewMuxSession(zap.NewNop(), serverConn, false, nil)
+	t.Cleanup(func() {
+		client.Close()
+		server.Close()
+	})
+	return client, server
+}
+
+// echo accepts streams and writes back everything read until the stream is half-closed
+func echo(session *MuxSession) {
+	for {
+		stream, err := session.AcceptStream()
+		if err != nil {
+			return
+		}
+		go func() {
+			io.Copy(stream, stream)
+			stream.CloseWrite()
+		}()
+	}
+}
+
+func TestMuxOpenAndAcceptStream(t *testing.T) {
+	client, server := newTestSessions(t)
+	go echo(client)
+
+	stream, err := server.OpenStream()
+	require.NoError(t, err)
+	defer stream.Close()
+
+	_, err = stream.Write([]byte("hello"))
+	require.NoError(t, err)
+	require.NoError(t, stream.CloseWrite())
+
+	resp, err := io.ReadAll(stream)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(resp))
+}
+
+func TestMuxConcurrentStreamsDoNotInterleave(t *testing.T) {
+	client, server := newTestSessions(t)
+	go echo(client)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			stream, err := server.OpenStream()
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer stream.Close()
+
+			payload := bytes.Repeat([]byte(fmt.Sprintf("stream-%d;", i)), 1000)
+			go func() {
+				stream.Write(payload)
+				stream.CloseWrite()
+			}()
+			resp, err := io.ReadAll(stream)
+			assert.NoError(t, err)
+			assert.Equal(t, payload, resp)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestMuxFlowControlLargeTransfer(t *testing.T) {
+	client, server := newTestSessions(t)
+
+	// Send several windows worth of data to a reader that starts late
+	payload := make([]byte, 4*int(muxInitialStreamWindow)+123)
+	_, err := rand.Read(payload)
+	require.NoError(t, err)
+
+	go func() {
+		stream, err := client.OpenStream()
+		if err != nil {
+			return
+		}
+		stream.Write(payload)
+		stream.CloseWrite()
+	}()
+
+	stream, err := server.AcceptStream()
+	require.NoError(t, err)
+	time.Sleep(50 * time.Millisecond)
+	received, err := io.ReadAll(stream)
+	require.NoError(t, err)
+	assert.Equal(t, payload, received)
+}
+
+func TestMuxHalfClose(t *testing.T) {
+	client, server := newTestSessions(t)
+
+	clientStream, err := client.OpenStream()
+	require.NoError(t, err)
+	serverStream, err := server.AcceptStream()
+	require.NoError(t, err)
+
+	// The client is done writing but can still read what the server sends back
+	_, err = clientStream.Write([]byte("request"))
+	require.NoError(t, err)
+	require.NoError(t, clientStream.CloseWrite())
+	_, err = clientStream.Write([]byte("more"))
+	assert.ErrorIs(t, err, ErrStreamClosed)
+
+	req, err := io.ReadAll(serverStream)
+	require.NoError(t, err)
+	assert.Equal(t, "request", string(req))
+
+	_, err = serverStream.Write([]byte("response"))
+	require.NoError(t, err)
+	require.NoError(t, serverStream.Close())
+
+	resp, err := io.ReadAll(clientStream)
+	require.NoError(t, err)
+	assert.Equal(t, "response", string(resp))
+	require.NoError(t, clientStream.Close())
+
+	assert.Eventually(t, func() bool {
+		return client.NumStreams() == 0 && server.NumStreams() == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestMuxReset(t *testing.T) {
+	client, server := newTestSessions(t)
+
+	clientStream, err := client.OpenStream()
+	require.NoError(t, err)
+	serverStream, err := server.AcceptStream()
+	require.NoError(t, err)
+
+	require.NoError(t, serverStream.(*MuxStream).Reset())
+
+	_, err = clientStream.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, ErrStreamReset)
+	_, err = clientStream.Write([]byte("data"))
+	assert.ErrorIs(t, err, ErrStreamReset)
+}
+
+func TestMuxReadDeadline(t *testing.T) {
+	client, server := newTestSessions(t)
+
+	clientStream, err := client.OpenStream()
+	require.NoError(t, err)
+	_, err = server.AcceptStream()
+	require.NoError(t, err)
+
+	require.NoError(t, clientStream.SetReadDeadline(time.Now().Add(20*time.Millisecond)))
+	_, err = clientStream.Read(make([]byte, 1))
+	netErr, ok := err.(net.Error)
+	require.True(t, ok)
+	assert.True(t, netErr.Timeout())
+}
+
+func TestMuxWriteDeadline(t *testing.T) {
+	serverConn, peerConn := net.Pipe()
+	server := NewMuxSession(zap.NewNop(), serverConn, false, nil)
+	t.Cleanup(func() {
+		server.Close()
+		peerConn.Close()
+	})
+	// The peer opens two streams and never reads, so the server blocks writing the first frame it sends
+	for _, id := range []uint32{1, 3} {
+		hdr := newMuxHeader(muxFrameWindowUpdate, muxFlagSYN, id, 0)
+		_, err := peerConn.Write(hdr[:])
+		require.NoError(t, err)
+	}
+	first, err := server.AcceptStream()
+	require.NoError(t, err)
+	second, err := server.AcceptStream()
+	require.NoError(t, err)
+
+	require.NoError(t, first.SetWriteDeadline(time.Now().Add(20*time.Millisecond)))
+	_, err = first.Write([]byte("hello"))
+	assert.ErrorIs(t, err, os.ErrDeadlineExceeded)
+
+	// Moving the deadline into the past wakes up a pending write
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := second.Write([]byte("hello"))
+		errCh <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	require.NoError(t, second.SetWriteDeadline(time.Now()))
+	select {
+	case err := <-errCh:
+		assert.ErrorIs(t, err, os.ErrDeadlineExceeded)
+	case <-time.After(time.Second):
+		t.Fatal("write was not woken up by its deadline")
+	}
+}
+
+func TestMuxSessionCloseClosesStreams(t *testing.T) {
+	client, server := newTestSessions(t)
+
+	clientStream, err := client.OpenStream()
+	require.NoError(t, err)
+	_, err = server.AcceptStream()
+	require.NoError(t, err)
+
+	require.NoError(t, server.Close())
+
+	_, err = clientStream.Read(make([]byte, 1))
+	assert.Error(t, err)
+	assert.Eventually(t, client.IsClosed, time.Second, 10*time.Millisecond)
+	_, err = client.OpenStream()
+	assert.ErrorIs(t, err, ErrSessionShutdown)
+	_, err = client.AcceptStream()
+	assert.Error(t, err)
+}
+
+func TestMuxGoAway(t *testing.T) {
+	client, server := newTestSessions(t)
+	go echo(client)
+
+	stream, err := server.OpenStream()
+	require.NoError(t, err)
+
+	require.NoError(t, client.GoAway())
+	assert.Eventually(t, server.IsDraining, time.Second, 10*time.Millisecond)
+	_, err = server.OpenStream()
+	assert.ErrorIs(t, err, ErrRemoteGoAway)
+
+	// Streams opened before the go away keep working
+	_, err = stream.Write([]byte("hello"))
+	require.NoError(t, err)
+	require.NoError(t, stream.CloseWrite())
+	resp, err := io.ReadAll(stream)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(resp))
+}
+
+func TestMuxPing(t *testing.T) {
+	client, server := newTestSessions(t)
+
+	assert.Zero(t, client.Latency())
+	rtt, err := client.Ping(time.Second)
+	require.NoError(t, err)
+	assert.Greater(t, rtt, time.Duration(0))
+	assert.Equal(t, rtt, client.Latency())
+
+	_, err = server.Ping(time.Second)
+	require.NoError(t, err)
+}
+
+func TestMuxKeepAliveClosesUnresponsiveSession(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer serverConn.Close()
+	// The remote end reads frames but never answers, like a half-open connection
+	go io.Copy(io.Discard, serverConn)
+
+	config := DefaultMuxConfig()
+	config.KeepAliveInterval = 20 * time.Millisecond
+	config.MaxMissedHeartbeats = 2
+	client := NewMuxSession(zap.NewNop(), clientConn, true, config)
+	defer client.Close()
+
+	select {
+	case <-client.CloseChan():
+	case <-time.After(time.Second):
+		t.Fatal("session was not closed after missing heartbeats")
+	}
+	assert.ErrorIs(t, client.shutdownError(), ErrKeepAliveFailed)
+}
+
+func TestMuxKeepAliveKeepsResponsiveSessionOpen(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	config := DefaultMuxConfig()
+	config.KeepAliveInterval = 20 * time.Millisecond
+	client := NewMuxSession(zap.NewNop(), clientConn, true, config)
+	server := NewMuxSession(zap.NewNop(), serverConn, false, nil)
+	defer client.Close()
+	defer server.Close()
+
+	time.Sleep(200 * time.Millisecond)
+	assert.True(t, client.IsOpen())
+	assert.Greater(t, client.Latency(), time.Duration(0))
+}
@@ -0,0 +1,197 @@
+package streaming_connection
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var (
+	ErrNoPortsAvailable = errors.New("no ports available")
+	ErrPortOutOfRange   = errors.New("port is outside of the allowed range")
+	ErrPortReserved     = errors.New("port is reserved by another owner")
+	ErrInvalidPortRange = errors.New("invalid port range, please provide format <min>-<max>")
+	ErrTooManyPorts     = errors.New("owner has reserved too many ports")
+)
+
+// PortReservation records that a public port belongs to an owner
+type PortReservation struct {
+	Port       int
+	Owner      string
+	ReservedAt time.Time
+	// ReleaseAt is set while the reservation is waiting out its grace period
+	ReleaseAt time.Time
+
+	releaseTimer *time.Timer
+}
+
+// PortAllocator hands out public ports from a range to TCP tunnels. Ports stay reserved for their owner until
+// they're released, optionally after a grace period that lets a disconnected agent reclaim its port.
+type PortAllocator struct {
+	slogger *zap.SugaredLogger
+	minPort int
+	maxPort int
+	// maxPerOwner is how many ports a single owner can hold so one of them can't use up the range
+	maxPerOwner int
+
+	lock         sync.Mutex
+	reservations map[int]*PortReservation
+}
+
+// NewPortAllocator allocates ports between minPort and maxPort inclusive, with each owner holding at most
+// maxPerOwner of them. Owners aren't limited when maxPerOwner is 0.
+func NewPortAllocator(logger *zap.Logger, minPort int, maxPort int, maxPerOwner int) *PortAllocator {
+	return &PortAllocator{
+		slogger:      logger.Sugar(),
+		minPort:      minPort,
+		maxPort:      maxPort,
+		maxPerOwner:  maxPerOwner,
+		reservations: make(map[int]*PortReservation),
+	}
+}
+
+// ParsePortRange parses a range in the format "20000-20100"
+func ParsePortRange(s string) (int, int, error) {
+	minStr, maxStr, ok := strings.Cut(s, "-")
+	if !ok {
+		return 0, 0, ErrInvalidPortRange
+	}
+	minPort, err := strconv.Atoi(strings.TrimSpace(minStr))
+	if err != nil {
+		return 0, 0, ErrInvalidPortRange
+	}
+	maxPort, err := strconv.Atoi(strings.TrimSpace(maxStr))
+	if err != nil {
+		return 0, 0, ErrInvalidPortRange
+	}
+	if minPort < 1 || maxPort > 65535 || minPort > maxPort {
+		return 0, 0, ErrInvalidPortRange
+	}
+	return minPort, maxPort, nil
+}
+
+// Reserve reserves port for owner, a free port is picked when port is 0. Asking for a port that owner already
+// holds returns it again and cancels any pending release, which is how agents reclaim their port after reconnecting.
+// ErrTooManyPorts is returned when owner already holds as many ports as it's allowed to.
+func (a *PortAllocator) Reserve(owner string, port int) (int, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if port == 0 {
+		if err := a.checkOwnerLimit(owner); err != nil {
+			return 0, err
+		}
+		return a.reserveFree(owner)
+	}
+	if port < a.minPort || port > a.maxPort {
+		return 0, fmt.Errorf("%w: %d", ErrPortOutOfRange, port)
+	}
+	if reservation, ok := a.reservations[port]; ok {
+		if reservation.Owner != owner {
+			return 0, fmt.Errorf("%w: %d", ErrPortReserved, port)
+		}
+		if reservation.releaseTimer != nil {
+			reservation.releaseTimer.Stop()
+			reservation.releaseTimer = nil
+			reservation.ReleaseAt = time.Time{}
+			a.slogger.Infof("Port %d was reclaimed by %s", port, owner)
+		}
+		return port, nil
+	}
+	if err := a.checkOwnerLimit(owner); err != nil {
+		return 0, err
+	}
+	a.reservations[port] = &PortReservation{Port: port, Owner: owner, ReservedAt: time.Now()}
+	return port, nil
+}
+
+// checkOwnerLimit returns ErrTooManyPorts if owner can't reserve another port, it must be called with lock held.
+// Ports waiting out their grace period still count as they can be reclaimed.
+func (a *PortAllocator) checkOwnerLimit(owner string) error {
+	if a.maxPerOwner == 0 {
+		return nil
+	}
+	held := 0
+	for _, reservation := range a.reservations {
+		if reservation.Owner == owner {
+			held++
+		}
+	}
+	if held >= a.maxPerOwner {
+		return fmt.Errorf("%w: %d", ErrTooManyPorts, a.maxPerOwner)
+	}
+	return nil
+}
+
+// reserveFree must be called with lock held
+func (a *PortAllocator) reserveFree(owner string) (int, error) {
+	size := a.maxPort - a.minPort + 1
+	// Start from a random port so ports aren't handed out in a predictable order
+	offset := rand.Intn(size)
+	for i := 0; i < size; i++ {
+		port := a.minPort + (offset+i)%size
+		if _, ok := a.reservations[port]; !ok {
+			a.reservations[port] = &PortReservation{Port: port, Owner: owner, ReservedAt: time.Now()}
+			return port, nil
+		}
+	}
+	return 0, ErrNoPortsAvailable
+}
+
+// Release releases port straight away
+func (a *PortAllocator) Release(port int) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if reservation, ok := a.reservations[port]; ok {
+		if reservation.releaseTimer != nil {
+			reservation.releaseTimer.Stop()
+		}
+		delete(a.reservations, port)
+	}
+}
+
+// ReleaseAfter releases port once delay has passed unless it's reserved again by its owner in the meantime.
+// onRelease is called with the allocator locked once the port has been released, so it must not call back
+// into the allocator.
+func (a *PortAllocator) ReleaseAfter(port int, delay time.Duration, onRelease func()) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	reservation, ok := a.reservations[port]
+	if !ok || reservation.releaseTimer != nil {
+		return
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(delay, func() {
+		a.lock.Lock()
+		defer a.lock.Unlock()
+		// The port may have been reclaimed after the timer fired but before we got the lock
+		if a.reservations[port] != reservation || reservation.releaseTimer != timer {
+			return
+		}
+		delete(a.reservations, port)
+		a.slogger.Infof("Released port %d", port)
+		onRelease()
+	})
+	reservation.releaseTimer = timer
+	reservation.ReleaseAt = time.Now().Add(delay)
+}
+
+// Reservations returns every reserved port ordered by port
+func (a *PortAllocator) Reservations() []PortReservation {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	reservations := make([]PortReservation, 0, len(a.reservations))
+	for _, reservation := range a.reservations {
+		reservations = append(reservations, *reservation)
+	}
+	slices.SortFunc(reservations, func(a, b PortReservation) int { return a.Port - b.Port })
+	return reservations
+}
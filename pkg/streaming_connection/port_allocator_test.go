@@ -0,0 +1,101 @@
+package streaming_connection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestParsePortRange(t *testing.T) {
+	minPort, maxPort, err := ParsePortRange("20000-20100")
+	require.NoError(t, err)
+	assert.Equal(t, 20000, minPort)
+	assert.Equal(t, 20100, maxPort)
+
+	for _, invalid := range []string{"", "20000", "a-b", "20100-20000", "0-10", "65000-70000"} {
+		_, _, err := ParsePortRange(invalid)
+		assert.ErrorIs(t, err, ErrInvalidPortRange, invalid)
+	}
+}
+
+func TestPortAllocatorReserve(t *testing.T) {
+	allocator := NewPortAllocator(zap.NewNop(), 20000, 20001, 0)
+
+	first, err := allocator.Reserve("alice", 0)
+	require.NoError(t, err)
+	second, err := allocator.Reserve("bob", 0)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int{20000, 20001}, []int{first, second})
+
+	_, err = allocator.Reserve("carol", 0)
+	assert.ErrorIs(t, err, ErrNoPortsAvailable)
+	_, err = allocator.Reserve("carol", 30000)
+	assert.ErrorIs(t, err, ErrPortOutOfRange)
+	_, err = allocator.Reserve("carol", first)
+	assert.ErrorIs(t, err, ErrPortReserved)
+
+	// Owners can ask for a port they already hold
+	port, err := allocator.Reserve("alice", first)
+	require.NoError(t, err)
+	assert.Equal(t, first, port)
+
+	allocator.Release(first)
+	port, err = allocator.Reserve("carol", first)
+	require.NoError(t, err)
+	assert.Equal(t, first, port)
+}
+
+func TestPortAllocatorLimitsOwners(t *testing.T) {
+	allocator := NewPortAllocator(zap.NewNop(), 20000, 20009, 2)
+
+	_, err := allocator.Reserve("alice", 20000)
+	require.NoError(t, err)
+	_, err = allocator.Reserve("alice", 20001)
+	require.NoError(t, err)
+	_, err = allocator.Reserve("alice", 0)
+	assert.ErrorIs(t, err, ErrTooManyPorts)
+	_, err = allocator.Reserve("alice", 20009)
+	assert.ErrorIs(t, err, ErrTooManyPorts)
+	// The ports alice holds can still be reclaimed and other owners aren't affected
+	_, err = allocator.Reserve("alice", 20000)
+	assert.NoError(t, err)
+	_, err = allocator.Reserve("bob", 0)
+	assert.NoError(t, err)
+
+	// Ports waiting to be released still count until they are
+	allocator.ReleaseAfter(20000, time.Hour, func() {})
+	_, err = allocator.Reserve("alice", 0)
+	assert.ErrorIs(t, err, ErrTooManyPorts)
+	allocator.Release(20000)
+	_, err = allocator.Reserve("alice", 0)
+	assert.NoError(t, err)
+}
+
+func TestPortAllocatorGracePeriod(t *testing.T) {
+	allocator := NewPortAllocator(zap.NewNop(), 20000, 20000, 0)
+	port, err := allocator.Reserve("alice", 0)
+	require.NoError(t, err)
+
+	// Reclaiming the port during the grace period cancels the release
+	released := make(chan struct{}, 1)
+	allocator.ReleaseAfter(port, 20*time.Millisecond, func() { released <- struct{}{} })
+	assert.False(t, allocator.Reservations()[0].ReleaseAt.IsZero())
+	_, err = allocator.Reserve("bob", port)
+	assert.ErrorIs(t, err, ErrPortReserved)
+	_, err = allocator.Reserve("alice", port)
+	require.NoError(t, err)
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(t, released, 0)
+	assert.Len(t, allocator.Reservations(), 1)
+
+	allocator.ReleaseAfter(port, 20*time.Millisecond, func() { released <- struct{}{} })
+	select {
+	case <-released:
+	case <-time.After(time.Second):
+		t.Fatal("port was not released")
+	}
+	assert.Empty(t, allocator.Reservations())
+}
@@ -0,0 +1,292 @@
+package streaming_connection
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/quic-go/quic-go"
+	"go.uber.org/zap"
+)
+
+/*
+ * Agents can connect over QUIC instead of TCP. QUIC has its own streams so there's no need for the MuxSession,
+ * every request gets a native stream and a lost packet only holds up the stream it belongs to rather than
+ * every request on the connection. The agent opens the first stream, the control stream, and makes the
+ * handshake on it exactly as it would over TCP. The control stream then carries the heartbeats used to
+ * measure latency and the go away sent when either end starts shutting down.
+ *
+ * QUIC connections aren't tied to the address they were made from, when the agent's address changes, i.e
+ * because a laptop moved to another Wi-Fi network, the server validates the new path and carries on over it
+ * without any of the streams noticing.
+ **/
+
+const (
+	// QuicALPN is the application protocol negotiated by agents connecting over QUIC
+	QuicALPN = "yuka"
+
+	// quicKeepAlivePeriod keeps NAT bindings open and means the server sees the agent's new address soon
+	// after it changes
+	quicKeepAlivePeriod = 15 * time.Second
+	quicMaxIdleTimeout  = 45 * time.Second
+	// QuicHandshakeTimeout is kept short so an agent whose UDP traffic is blocked falls back to TCP quickly
+	QuicHandshakeTimeout = 3 * time.Second
+	quicMaxStreams       = 1024
+	// quicCloseTimeout is how long the remote end has to read what's left of the control stream before
+	// the connection is closed under it
+	quicCloseTimeout = time.Second
+
+	// quicControlMessageSize is the size of a message on the control stream, a type followed by a uint64
+	quicControlMessageSize = 9
+)
+
+type quicControlMessage uint8
+
+const (
+	// quicControlPing asks for a pong carrying the same value, the time the ping was sent
+	quicControlPing quicControlMessage = iota + 1
+	quicControlPong
+	// quicControlGoAway announces that the sender is shutting down and shouldn't be sent new streams
+	quicControlGoAway
+)
+
+// quicErrorCode is sent when closing a connection or cancelling a stream, no error is ever sent
+const quicErrorCode = 0
+
+var ErrUnexpectedControlMessage = errors.New("unexpected control message")
+
+// QuicConfig returns the configuration used by both ends of a QUIC connection
+func QuicConfig() *quic.Config {
+	return &quic.Config{
+		HandshakeIdleTimeout: QuicHandshakeTimeout,
+		MaxIdleTimeout:       quicMaxIdleTimeout,
+		KeepAlivePeriod:      quicKeepAlivePeriod,
+		MaxIncomingStreams:   quicMaxStreams,
+	}
+}
+
+// DialQuic connects to the QUIC tunnel listener at address and opens the control stream the handshake is
+// made on
+func DialQuic(ctx context.Context, address string, tlsConfig *tls.Config) (*QuicStream, error) {
+	conn, err := quic.DialAddr(ctx, address, tlsConfig, QuicConfig())
+	if err != nil {
+		return nil, err
+	}
+	stream, err := conn.OpenStreamSync(ctx)
+	if err != nil {
+		conn.CloseWithError(quicErrorCode, "")
+		return nil, err
+	}
+	return newQuicControlStream(conn, stream), nil
+}
+
+// QuicStream is a Stream over a native QUIC stream
+type QuicStream struct {
+	*quic.Stream
+	conn *quic.Conn
+	// numStreams counts the open streams of the connection, it's nil for the control stream
+	numStreams *atomic.Int32
+	closeOnce  sync.Once
+}
+
+func newQuicStream(conn *quic.Conn, stream *quic.Stream, numStreams *atomic.Int32) *QuicStream {
+	numStreams.Add(1)
+	return &QuicStream{Stream: stream, conn: conn, numStreams: numStreams}
+}
+
+// newQuicControlStream wraps the control stream of conn, closing it closes the whole connection
+func newQuicControlStream(conn *quic.Conn, stream *quic.Stream) *QuicStream {
+	return &QuicStream{Stream: stream, conn: conn}
+}
+
+func (self *QuicStream) ID() uint32 {
+	return uint32(self.StreamID())
+}
+
+// CloseWrite sends the end of the stream, closing a QUIC stream only closes the side it writes to
+func (self *QuicStream) CloseWrite() error {
+	return self.Stream.Close()
+}
+
+// Close closes both sides of the stream, telling the remote end to stop sending anything still unread.
+// Closing the control stream closes the connection once the remote end has read what's left of it, i.e
+// the rejection of its handshake.
+func (self *QuicStream) Close() error {
+	err := net.ErrClosed
+	self.closeOnce.Do(func() {
+		self.CancelRead(quicErrorCode)
+		err = self.Stream.Close()
+		if self.numStreams != nil {
+			self.numStreams.Add(-1)
+			return
+		}
+		go func() {
+			select {
+			case <-self.conn.Context().Done():
+			case <-time.After(quicCloseTimeout):
+			}
+			self.conn.CloseWithError(quicErrorCode, "")
+		}()
+	})
+	return err
+}
+
+func (self *QuicStream) LocalAddr() net.Addr {
+	return self.conn.LocalAddr()
+}
+
+func (self *QuicStream) RemoteAddr() net.Addr {
+	return self.conn.RemoteAddr()
+}
+
+// QuicStreamingConnection is a StreamingConnection over a QUIC connection, each stream is a native QUIC stream
+type QuicStreamingConnection struct {
+	slogger zap.SugaredLogger
+	conn    *quic.Conn
+	control *QuicStream
+	hello   *Hello
+
+	controlLock sync.Mutex
+	numStreams  atomic.Int32
+	latency     atomic.Int64
+	// remoteGoAway is set once the remote end has announced it is shutting down
+	remoteGoAway atomic.Bool
+}
+
+// NewQuicStreamingConnection builds a QuicStreamingConnection once the handshake has been made on control,
+// the first stream opened on the connection. The agent's hello is needed by the server to add the
+// connection to the pool, the agent passes its own.
+func NewQuicStreamingConnection(logger *zap.Logger, control *QuicStream, hello *Hello, settings SessionSettings) *QuicStreamingConnection {
+	connection := &QuicStreamingConnection{
+		slogger: *logger.Sugar(),
+		conn:    control.conn,
+		control: control,
+		hello:   hello,
+	}
+	go connection.readControl()
+	if interval := settings.HeartbeatInterval(); interval > 0 {
+		go connection.keepAlive(interval)
+	}
+	return connection
+}
+
+func (self *QuicStreamingConnection) OpenStream() (Stream, error) {
+	stream, err := self.conn.OpenStreamSync(self.conn.Context())
+	if err != nil {
+		return nil, err
+	}
+	return newQuicStream(self.conn, stream, &self.numStreams), nil
+}
+
+func (self *QuicStreamingConnection) AcceptStream() (Stream, error) {
+	stream, err := self.conn.AcceptStream(self.conn.Context())
+	if err != nil {
+		return nil, err
+	}
+	return newQuicStream(self.conn, stream, &self.numStreams), nil
+}
+
+// GoAway tells the remote end that the connection is shutting down so it should stop opening new streams.
+// Existing streams are unaffected, allowing them to finish before the connection is closed.
+func (self *QuicStreamingConnection) GoAway() error {
+	return self.writeControl(quicControlGoAway, 0)
+}
+
+func (self *QuicStreamingConnection) Close() error {
+	return self.conn.CloseWithError(quicErrorCode, "")
+}
+
+func (self *QuicStreamingConnection) IsOpen() bool {
+	return self.conn.Context().Err() == nil
+}
+
+func (self *QuicStreamingConnection) CloseChan() <-chan struct{} {
+	return self.conn.Context().Done()
+}
+
+func (self *QuicStreamingConnection) NumStreams() int {
+	return int(self.numStreams.Load())
+}
+
+func (self *QuicStreamingConnection) IsDraining() bool {
+	return self.remoteGoAway.Load()
+}
+
+// Latency returns the round trip time of the last answered heartbeat, zero if none has been answered yet
+func (self *QuicStreamingConnection) Latency() time.Duration {
+	return time.Duration(self.latency.Load())
+}
+
+func (self *QuicStreamingConnection) RemoteAddr() net.Addr {
+	return self.conn.RemoteAddr()
+}
+
+// ForTunnel returns the connection to add to the pool for the tunnel served on hostname, see
+// TcpStreamingConnection.ForTunnel
+func (self *QuicStreamingConnection) ForTunnel(hostname string) StreamingConnection {
+	return forTunnel(self, self.hello, hostname)
+}
+
+func (self *QuicStreamingConnection) writeControl(message quicControlMessage, value uint64) error {
+	var b [quicControlMessageSize]byte
+	b[0] = uint8(message)
+	binary.BigEndian.PutUint64(b[1:], value)
+
+	self.controlLock.Lock()
+	defer self.controlLock.Unlock()
+	_, err := self.control.Write(b[:])
+	return err
+}
+
+// readControl handles the messages sent on the control stream, the connection is closed once the remote
+// end closes the control stream
+func (self *QuicStreamingConnection) readControl() {
+	defer self.Close()
+
+	var b [quicControlMessageSize]byte
+	for {
+		if _, err := io.ReadFull(self.control, b[:]); err != nil {
+			if self.IsOpen() {
+				self.slogger.Debugf("Control stream from %s closed: %v", self.RemoteAddr(), err)
+			}
+			return
+		}
+		value := binary.BigEndian.Uint64(b[1:])
+		switch quicControlMessage(b[0]) {
+		case quicControlPing:
+			if err := self.writeControl(quicControlPong, value); err != nil {
+				return
+			}
+		case quicControlPong:
+			self.latency.Store(int64(time.Since(time.Unix(0, int64(value)))))
+		case quicControlGoAway:
+			self.remoteGoAway.Store(true)
+		default:
+			self.slogger.Warnf("Closing connection from %s: %v %d", self.RemoteAddr(), ErrUnexpectedControlMessage, b[0])
+			return
+		}
+	}
+}
+
+// keepAlive measures the latency every interval, QUIC's own keep alives take care of noticing when the
+// remote end has gone away
+func (self *QuicStreamingConnection) keepAlive(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := self.writeControl(quicControlPing, uint64(time.Now().UnixNano())); err != nil {
+				return
+			}
+		case <-self.CloseChan():
+			return
+		}
+	}
+}
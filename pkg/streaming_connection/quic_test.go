@@ -0,0 +1,130 @@
+package streaming_connection
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"testing"
+	"time"
+	"yuka/pkg/tls_helper"
+
+	"github.com/quic-go/quic-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// dialQuicTunnel starts a QUIC listener on loopback that hands each connection to tunnel and returns the
+// control stream of a connection to it
+func dialQuicTunnel(t *testing.T, tunnel *TcpTunnel) *QuicStream {
+	ca, err := tls_helper.NewCA("yuka")
+	require.NoError(t, err)
+	certificate, err := ca.IssueServerCertificate("127.0.0.1")
+	require.NoError(t, err)
+	tlsConfig := &tls.Config{Certificates: []tls.Certificate{certificate}, NextProtos: []string{QuicALPN}}
+	listener, err := quic.ListenAddr("127.0.0.1:0", tlsConfig, QuicConfig())
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept(context.Background())
+			if err != nil {
+				return
+			}
+			go tunnel.ServeQuic(conn)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	control, err := DialQuic(ctx, listener.Addr().String(), &tls.Config{RootCAs: ca.CertPool(), NextProtos: []string{QuicALPN}})
+	require.NoError(t, err)
+	t.Cleanup(func() { control.Close() })
+	return control
+}
+
+func TestTcpTunnelServesQuicAgents(t *testing.T) {
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	tunnel := NewTcpTunnel(zap.NewNop(), pool, TcpTunnelOptions{PublicHost: "yuka.dev"})
+	control := dialQuicTunnel(t, tunnel)
+
+	hello := NewHello("dev", "", []TunnelRequest{{Protocol: TunnelProtocolHttp, Hostname: "foo"}})
+	reply, err := ClientHandshake(control, hello, time.Second)
+	require.NoError(t, err)
+	agent := NewQuicStreamingConnection(zap.NewNop(), control, hello, reply.Settings)
+	defer agent.Close()
+	hostnames := make(chan string, 2)
+	go echoTunnel(agent, hostnames)
+
+	require.Eventually(t, func() bool { return len(pool.GetConnections("foo")) == 1 }, time.Second, 10*time.Millisecond)
+	connection, _, err := pool.SelectConnectionForHost("foo.yuka.dev", "127.0.0.1")
+	require.NoError(t, err)
+
+	// Each request gets a stream of its own, one being held up doesn't affect the other
+	slow, err := connection.OpenStream()
+	require.NoError(t, err)
+	_, err = slow.Write([]byte("slow"))
+	require.NoError(t, err)
+	stream, err := connection.OpenStream()
+	require.NoError(t, err)
+	_, err = stream.Write([]byte("hello"))
+	require.NoError(t, err)
+	require.NoError(t, stream.CloseWrite())
+	b, err := io.ReadAll(stream)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(b))
+	assert.Equal(t, "foo", <-hostnames)
+	require.NoError(t, stream.Close())
+
+	require.NoError(t, slow.CloseWrite())
+	b, err = io.ReadAll(slow)
+	require.NoError(t, err)
+	assert.Equal(t, "slow", string(b))
+	require.NoError(t, slow.Close())
+	require.Eventually(t, func() bool { return connection.NumStreams() == 0 }, time.Second, 10*time.Millisecond)
+
+	// The agent announcing it's shutting down stops new requests being sent to it
+	require.NoError(t, agent.GoAway())
+	require.Eventually(t, connection.IsDraining, time.Second, 10*time.Millisecond)
+
+	// The agent going away removes its tunnels from the pool
+	agent.Close()
+	require.Eventually(t, func() bool { return len(pool.GetConnections("foo")) == 0 }, time.Second, 10*time.Millisecond)
+}
+
+func TestTcpTunnelRejectsQuicAgents(t *testing.T) {
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	tunnel := NewTcpTunnel(zap.NewNop(), pool, TcpTunnelOptions{
+		PublicHost:    "yuka.dev",
+		Authenticator: fakeAuthenticator{},
+	})
+	control := dialQuicTunnel(t, tunnel)
+
+	_, err := ClientHandshake(control, NewHello("dev", "wrong-token", []TunnelRequest{{Protocol: TunnelProtocolHttp}}), time.Second)
+	var rejected *HandshakeRejectedError
+	require.True(t, errors.As(err, &rejected), "unexpected error %v", err)
+	assert.Equal(t, ErrInvalidAuthToken.Error(), rejected.Reason)
+
+	// The server closes the connection once the agent has read the rejection
+	select {
+	case <-control.conn.Context().Done():
+	case <-time.After(2 * quicCloseTimeout):
+		t.Fatal("connection wasn't closed")
+	}
+}
+
+func TestQuicStreamingConnectionMeasuresLatency(t *testing.T) {
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	tunnel := NewTcpTunnel(zap.NewNop(), pool, TcpTunnelOptions{PublicHost: "yuka.dev"})
+	control := dialQuicTunnel(t, tunnel)
+
+	hello := NewHello("dev", "", []TunnelRequest{{Protocol: TunnelProtocolHttp, Hostname: "foo"}})
+	_, err := ClientHandshake(control, hello, time.Second)
+	require.NoError(t, err)
+	agent := NewQuicStreamingConnection(zap.NewNop(), control, hello, SessionSettings{HeartbeatIntervalMs: 10})
+	defer agent.Close()
+
+	require.Eventually(t, func() bool { return agent.Latency() > 0 }, time.Second, 10*time.Millisecond)
+	assert.Less(t, agent.Latency(), time.Second)
+}
@@ -0,0 +1,90 @@
+package streaming_connection
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"yuka/pkg/tls_helper"
+
+	"github.com/quic-go/quic-go"
+	"go.uber.org/zap"
+)
+
+// QuicTunnelOptions configures a QuicTunnel
+type QuicTunnelOptions struct {
+	// ListenPort is the UDP port agents connect to, usually the same as the TcpTunnel's TCP port
+	ListenPort int
+	// TLSConfig holds the certificate presented to agents, usually the same as the TcpTunnel's. A
+	// certificate is generated when it's nil.
+	TLSConfig *tls.Config
+}
+
+// QuicTunnel listens for agents connecting over QUIC and hands them on to the TcpTunnel, whose pool their
+// tunnels are added to
+type QuicTunnel struct {
+	slogger zap.SugaredLogger
+	tunnel  *TcpTunnel
+	options QuicTunnelOptions
+}
+
+func NewQuicTunnel(logger *zap.Logger, tunnel *TcpTunnel, options QuicTunnelOptions) *QuicTunnel {
+	return &QuicTunnel{
+		slogger: *logger.Sugar(),
+		tunnel:  tunnel,
+		options: options,
+	}
+}
+
+// Listen is a blocking call that starts up the QUIC server
+//
+// Will close on ctx.Done() being called
+func (self *QuicTunnel) Listen(ctx context.Context) error {
+	tlsConfig, err := self.tlsConfig()
+	if err != nil {
+		return err
+	}
+	listener, err := quic.ListenAddr(fmt.Sprintf(":%v", self.options.ListenPort), tlsConfig, QuicConfig())
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+
+	self.slogger.Infof("QuicTunnel is listening on port %v", self.options.ListenPort)
+
+	for {
+		conn, err := listener.Accept(ctx)
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, quic.ErrServerClosed) {
+				self.slogger.Info("Shutting down QUIC tunnel...")
+				return nil
+			}
+			self.slogger.Errorf("Error accepting QUIC connection: %v", err)
+			continue
+		}
+		go self.tunnel.ServeQuic(conn)
+	}
+}
+
+// tlsConfig returns the config for the QUIC listener, which can't be used without TLS. When the tunnel
+// listener isn't configured with a certificate one is generated, agents then have nothing to verify it
+// against but the connection is still no less secure than plaintext TCP.
+func (self *QuicTunnel) tlsConfig() (*tls.Config, error) {
+	if self.options.TLSConfig != nil {
+		tlsConfig := self.options.TLSConfig.Clone()
+		tlsConfig.NextProtos = []string{QuicALPN}
+		return tlsConfig, nil
+	}
+	ca, err := tls_helper.NewCA("yuka")
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate a certificate: %v", err)
+	}
+	certificate, err := ca.IssueServerCertificate()
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate a certificate: %v", err)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		NextProtos:   []string{QuicALPN},
+	}, nil
+}
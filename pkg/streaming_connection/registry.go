@@ -0,0 +1,44 @@
+package streaming_connection
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrHostnameReserved    = errors.New("hostname is reserved by another owner")
+	ErrHostnameNotReserved = errors.New("custom domain must be reserved and verified by its owner")
+)
+
+// Reservations looks up the owners hostnames are reserved for. A reserved hostname can only be claimed by
+// its owner, even while no agent is serving it. Custom domains can only be claimed once they're reserved and
+// their owner has proven they own them. Hostnames are looked up by their canonical hostname, see
+// CanonicalHostname.
+type Reservations interface {
+	// ReservedFor returns the owner hostname is reserved for, empty if it isn't reserved or its reservation is
+	// still pending verification
+	ReservedFor(hostname string) (string, error)
+}
+
+// TunnelSession is a tunnel served by an agent, from when it's added to the pool until the agent goes away
+type TunnelSession struct {
+	// ID is set by the TunnelRecorder when the session starts
+	ID string
+	// Identity is who the agent authenticated as, nil when agents aren't authenticated
+	Identity     *AgentIdentity
+	AgentVersion string
+	AgentAddress string
+	Protocol     string
+	// Hostname is the hostname of the tunnel's TunnelAssignment
+	Hostname  string
+	PublicURL string
+	StartedAt time.Time
+}
+
+// TunnelRecorder is told about the tunnels agents serve, i.e to keep a registry of them
+type TunnelRecorder interface {
+	// TunnelStarted is called once the tunnel of session has been added to the pool
+	TunnelStarted(session *TunnelSession) error
+	// TunnelEnded is called once the agent serving the tunnel of session has gone away
+	TunnelEnded(session *TunnelSession) error
+}
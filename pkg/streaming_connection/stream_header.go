@@ -0,0 +1,32 @@
+package streaming_connection
+
+import (
+	"net"
+	"time"
+)
+
+// StreamHeader is written by the server at the start of every stream it opens when the agent advertised
+// CapabilityStreamHeaders. It uses the same framing as the handshake messages.
+type StreamHeader struct {
+	// Hostname is the hostname of the tunnel the stream is for, as given in its TunnelAssignment
+	Hostname string `json:"hostname"`
+}
+
+// WriteStreamHeader writes header to the start of a stream
+func WriteStreamHeader(stream net.Conn, header *StreamHeader) error {
+	return writeHandshakeMessage(stream, header)
+}
+
+// ReadStreamHeader reads the StreamHeader from the start of a stream
+func ReadStreamHeader(stream net.Conn, timeout time.Duration) (*StreamHeader, error) {
+	if err := stream.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+	defer stream.SetReadDeadline(time.Time{})
+
+	var header StreamHeader
+	if err := readHandshakeMessage(stream, &header); err != nil {
+		return nil, err
+	}
+	return &header, nil
+}
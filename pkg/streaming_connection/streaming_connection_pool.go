@@ -2,43 +2,316 @@ package streaming_connection
 
 import (
 	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
 
 	"go.uber.org/zap"
 )
 
 var (
 	ErrConnectionNotFound = errors.New("No connection found")
+	ErrHostnameClaimed    = errors.New("hostname is claimed by another owner")
 )
 
+type PoolEventType int
+
+const (
+	// PoolEventRegistered is fired when a connection is added to the pool
+	PoolEventRegistered PoolEventType = iota
+	// PoolEventDeregistered is fired when a connection is removed from the pool, either explicitly or
+	// because the connection was closed
+	PoolEventDeregistered
+)
+
+func (t PoolEventType) String() string {
+	switch t {
+	case PoolEventRegistered:
+		return "registered"
+	case PoolEventDeregistered:
+		return "deregistered"
+	default:
+		return "unknown"
+	}
+}
+
+// PoolEvent describes a change to the connections in a StreamingConnectionPool
+type PoolEvent struct {
+	Type       PoolEventType
+	Hostname   string
+	Connection StreamingConnection
+}
+
+// PoolEventHandler is called for every PoolEvent. Handlers are called synchronously in the order events
+// occur so they should return quickly.
+type PoolEventHandler func(event PoolEvent)
+
+type poolEntry struct {
+	// id is unique within the pool and stable for the lifetime of the connection
+	id   uint64
+	conn StreamingConnection
+	// removed is closed once the entry is removed from the pool, stopping its eviction watcher
+	removed chan struct{}
+}
+
+// poolTunnel is the set of connections registered for a hostname
+type poolTunnel struct {
+	// owner is who registered the first connection for the hostname, only connections from the same owner
+	// can join it
+	owner    string
+	strategy LoadBalancingStrategy
+	entries  []*poolEntry
+	// counter is incremented on every selection and used for round robin
+	counter atomic.Uint64
+}
+
+// StreamingConnectionPool is safe for concurrent use and must be shared by pointer. Many connections can be
+// registered for the same hostname, in which case requests are balanced between them.
 type StreamingConnectionPool struct {
 	slogger *zap.SugaredLogger
-	// TODO: Make this interface that supports any type of streaming connection, not just websockets
-	connections map[string]StreamingConnection
+	// publicHost is the host the tunnel router is publicly reachable on, see CanonicalHostname
+	publicHost string
+
+	lock        sync.RWMutex
+	connections map[string]*poolTunnel
+	nextEntryID uint64
+
+	// eventLock serializes event delivery so subscribers see events in the order they occurred
+	eventLock        sync.Mutex
+	subscriberLock   sync.RWMutex
+	subscribers      map[int]PoolEventHandler
+	nextSubscriberID int
 }
 
 // NewStreamingConnectionPool provides an interface for adding/removing existing streaming connections.
-func NewStreamingConnectionPool(logger *zap.Logger) *StreamingConnectionPool {
+func NewStreamingConnectionPool(logger *zap.Logger, publicHost string) *StreamingConnectionPool {
 	return &StreamingConnectionPool{
-		connections: make(map[string]StreamingConnection),
+		publicHost:  publicHost,
+		connections: make(map[string]*poolTunnel),
+		subscribers: make(map[int]PoolEventHandler),
 		slogger:     logger.Sugar(),
 	}
 }
 
-func (c *StreamingConnectionPool) AddConnection(hostname string, conn StreamingConnection) {
+// AddConnection registers conn for hostname alongside any existing connections. The hostname is claimed by
+// owner until its last connection is removed and ErrHostnameClaimed is returned if another owner holds it.
+// The connection is evicted from the pool automatically once it is closed.
+func (c *StreamingConnectionPool) AddConnection(hostname string, owner string, conn StreamingConnection) error {
 	c.slogger.Debugf("Adding connection for hostname %s", hostname)
-	c.connections[hostname] = conn
+
+	c.eventLock.Lock()
+	c.lock.Lock()
+	tunnel, ok := c.connections[hostname]
+	if !ok {
+		tunnel = &poolTunnel{owner: owner, strategy: DefaultLoadBalancingStrategy}
+		c.connections[hostname] = tunnel
+	} else if tunnel.owner != owner {
+		c.lock.Unlock()
+		c.eventLock.Unlock()
+		return ErrHostnameClaimed
+	}
+	c.nextEntryID++
+	entry := &poolEntry{id: c.nextEntryID, conn: conn, removed: make(chan struct{})}
+	tunnel.entries = append(tunnel.entries, entry)
+	c.lock.Unlock()
+
+	c.publish(PoolEvent{Type: PoolEventRegistered, Hostname: hostname, Connection: conn})
+	c.eventLock.Unlock()
+
+	go c.evictOnClose(hostname, entry)
+	return nil
+}
+
+// CheckOwner returns ErrHostnameClaimed if hostname is currently claimed by someone other than owner
+func (c *StreamingConnectionPool) CheckOwner(hostname string, owner string) error {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if tunnel, ok := c.connections[hostname]; ok && tunnel.owner != owner {
+		return ErrHostnameClaimed
+	}
+	return nil
+}
+
+// Owner returns who claimed hostname, empty if it has no connections or they were registered without an owner
+func (c *StreamingConnectionPool) Owner(hostname string) string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if tunnel, ok := c.connections[hostname]; ok {
+		return tunnel.owner
+	}
+	return ""
+}
+
+// SetLoadBalancingStrategy sets how requests for hostname are balanced between its connections. The strategy
+// is kept for as long as hostname has at least one connection.
+func (c *StreamingConnectionPool) SetLoadBalancingStrategy(hostname string, strategy LoadBalancingStrategy) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tunnel, ok := c.connections[hostname]
+	if !ok {
+		return ErrConnectionNotFound
+	}
+	if tunnel.strategy != strategy {
+		c.slogger.Infof("Using %s load balancing for hostname %s", strategy, hostname)
+		tunnel.strategy = strategy
+	}
+	return nil
 }
 
+// GetConnection returns a connection for hostname using its load balancing strategy
 func (c *StreamingConnectionPool) GetConnection(hostname string) (StreamingConnection, error) {
+	return c.SelectConnection(hostname, "")
+}
+
+// SelectConnection returns a connection for hostname using its load balancing strategy. clientAddr is the
+// address of the client making the request and is used for consistent hashing.
+func (c *StreamingConnectionPool) SelectConnection(hostname string, clientAddr string) (StreamingConnection, error) {
 	c.slogger.Debugf("Getting connection for hostname %s", hostname)
-	conn, ok := c.connections[hostname]
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	tunnel, ok := c.connections[hostname]
 	if !ok {
 		return nil, ErrConnectionNotFound
 	}
-	return conn, nil
+	entry := selectEntry(tunnel.strategy, tunnel.entries, tunnel.counter.Add(1), clientAddr)
+	if entry == nil {
+		return nil, ErrConnectionNotFound
+	}
+	return entry.conn, nil
+}
+
+// GetConnectionForHost resolves the connection for the host of an inbound request along with the
+// hostname it was registered under, i.e "foo.yuka.dev:8081" will match a connection registered as "foo".
+// ErrInvalidHostname is returned when host has no canonical hostname, see CanonicalHostname.
+func (c *StreamingConnectionPool) GetConnectionForHost(host string) (StreamingConnection, string, error) {
+	return c.SelectConnectionForHost(host, "")
+}
+
+// SelectConnectionForHost is GetConnectionForHost but balances requests using the address of the client
+func (c *StreamingConnectionPool) SelectConnectionForHost(host string, clientAddr string) (StreamingConnection, string, error) {
+	hostname, err := c.CanonicalHostname(host)
+	if err != nil {
+		return nil, "", err
+	}
+	conn, err := c.SelectConnection(hostname, clientAddr)
+	if err != nil {
+		return nil, "", err
+	}
+	return conn, hostname, nil
+}
+
+// CanonicalHostname returns the hostname host is registered under in the pool, see CanonicalHostname
+func (c *StreamingConnectionPool) CanonicalHostname(host string) (string, error) {
+	return CanonicalHostname(host, c.publicHost)
 }
 
+// GetConnections returns every connection registered for hostname
+func (c *StreamingConnectionPool) GetConnections(hostname string) []StreamingConnection {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	tunnel, ok := c.connections[hostname]
+	if !ok {
+		return nil
+	}
+	conns := make([]StreamingConnection, 0, len(tunnel.entries))
+	for _, entry := range tunnel.entries {
+		conns = append(conns, entry.conn)
+	}
+	return conns
+}
+
+// RemoveConnection removes all connections for hostname from the pool. The connections themselves are left open.
 func (c *StreamingConnectionPool) RemoveConnection(hostname string) {
 	c.slogger.Debugf("Removing connection for hostname %s", hostname)
+	c.eventLock.Lock()
+	defer c.eventLock.Unlock()
+
+	c.lock.Lock()
+	tunnel, ok := c.connections[hostname]
+	if !ok {
+		c.lock.Unlock()
+		return
+	}
 	delete(c.connections, hostname)
+	for _, entry := range tunnel.entries {
+		close(entry.removed)
+	}
+	c.lock.Unlock()
+
+	for _, entry := range tunnel.entries {
+		c.publish(PoolEvent{Type: PoolEventDeregistered, Hostname: hostname, Connection: entry.conn})
+	}
+}
+
+// Hostnames returns all hostnames that currently have a connection
+func (c *StreamingConnectionPool) Hostnames() []string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	hostnames := make([]string, 0, len(c.connections))
+	for hostname := range c.connections {
+		hostnames = append(hostnames, hostname)
+	}
+	return hostnames
+}
+
+// Subscribe registers handler to be called for every event in the pool. The returned function unsubscribes it
+// and must not be called from within a handler.
+func (c *StreamingConnectionPool) Subscribe(handler PoolEventHandler) func() {
+	c.subscriberLock.Lock()
+	defer c.subscriberLock.Unlock()
+	id := c.nextSubscriberID
+	c.nextSubscriberID++
+	c.subscribers[id] = handler
+	return func() {
+		c.subscriberLock.Lock()
+		defer c.subscriberLock.Unlock()
+		delete(c.subscribers, id)
+	}
+}
+
+// removeEntry removes entry from the connections for hostname, returning false if it has already been removed
+func (c *StreamingConnectionPool) removeEntry(hostname string, entry *poolEntry) bool {
+	c.eventLock.Lock()
+	defer c.eventLock.Unlock()
+
+	c.lock.Lock()
+	tunnel, ok := c.connections[hostname]
+	if !ok {
+		c.lock.Unlock()
+		return false
+	}
+	index := slices.Index(tunnel.entries, entry)
+	if index < 0 {
+		c.lock.Unlock()
+		return false
+	}
+	tunnel.entries = slices.Delete(tunnel.entries, index, index+1)
+	if len(tunnel.entries) == 0 {
+		delete(c.connections, hostname)
+	}
+	close(entry.removed)
+	c.lock.Unlock()
+
+	c.publish(PoolEvent{Type: PoolEventDeregistered, Hostname: hostname, Connection: entry.conn})
+	return true
+}
+
+// evictOnClose waits for the connection of entry to close and then removes it from the pool
+func (c *StreamingConnectionPool) evictOnClose(hostname string, entry *poolEntry) {
+	select {
+	case <-entry.conn.CloseChan():
+		if c.removeEntry(hostname, entry) {
+			c.slogger.Infof("Evicted closed connection for hostname %s", hostname)
+		}
+	case <-entry.removed:
+	}
+}
+
+// publish must be called with eventLock held
+func (c *StreamingConnectionPool) publish(event PoolEvent) {
+	c.subscriberLock.RLock()
+	defer c.subscriberLock.RUnlock()
+	for _, handler := range c.subscribers {
+		handler(event)
+	}
 }
@@ -0,0 +1,359 @@
+package streaming_connection
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type fakeConnection struct {
+	closeOnce  sync.Once
+	closeCh    chan struct{}
+	numStreams atomic.Int32
+	draining   atomic.Bool
+}
+
+func newFakeConnection() *fakeConnection {
+	return &fakeConnection{closeCh: make(chan struct{})}
+}
+
+func (f *fakeConnection) OpenStream() (Stream, error)   { return nil, ErrSessionShutdown }
+func (f *fakeConnection) AcceptStream() (Stream, error) { return nil, ErrSessionShutdown }
+func (f *fakeConnection) CloseChan() <-chan struct{}    { return f.closeCh }
+func (f *fakeConnection) NumStreams() int               { return int(f.numStreams.Load()) }
+func (f *fakeConnection) IsDraining() bool              { return f.draining.Load() }
+func (f *fakeConnection) Latency() time.Duration        { return 0 }
+func (f *fakeConnection) RemoteAddr() net.Addr          { return &net.TCPAddr{} }
+
+func (f *fakeConnection) Close() error {
+	f.closeOnce.Do(func() { close(f.closeCh) })
+	return nil
+}
+
+func (f *fakeConnection) IsOpen() bool {
+	select {
+	case <-f.closeCh:
+		return false
+	default:
+		return true
+	}
+}
+
+func TestPoolGetConnectionForHost(t *testing.T) {
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	foo := newFakeConnection()
+	bar := newFakeConnection()
+	pool.AddConnection("foo", "", foo)
+	pool.AddConnection("bar.example.com", "", bar)
+
+	tests := []struct {
+		host     string
+		expected StreamingConnection
+		hostname string
+	}{
+		{"foo.yuka.dev", foo, "foo"},
+		{"FOO.yuka.dev:8081", foo, "foo"},
+		{"foo", foo, "foo"},
+		{"bar.example.com", bar, "bar.example.com"},
+		{"bar.example.com.", bar, "bar.example.com"},
+	}
+	for _, test := range tests {
+		conn, hostname, err := pool.GetConnectionForHost(test.host)
+		require.NoError(t, err, test.host)
+		assert.Equal(t, test.expected, conn, test.host)
+		assert.Equal(t, test.hostname, hostname, test.host)
+	}
+
+	// A host only has one canonical name, other domains aren't matched on their first label
+	for _, host := range []string{"baz.yuka.dev", "foo.example.com", "bar"} {
+		_, _, err := pool.GetConnectionForHost(host)
+		assert.ErrorIs(t, err, ErrConnectionNotFound, host)
+	}
+}
+
+func TestPoolRejectsInvalidHosts(t *testing.T) {
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev:8081")
+	pool.AddConnection("foo", "", newFakeConnection())
+	pool.AddConnection(tcpPoolKey(20000), "", newFakeConnection())
+	pool.AddConnection(tlsPoolKey("foo"), "", newFakeConnection())
+
+	for _, host := range []string{"", "tls:foo", "yuka.dev", "a.foo.yuka.dev", "foo_bar.yuka.dev", "tcp:20000:1"} {
+		_, _, err := pool.GetConnectionForHost(host)
+		assert.ErrorIs(t, err, ErrInvalidHostname, host)
+	}
+	// The port is dropped from the host so it can't address the pool keys of TCP and UDP tunnels
+	_, _, err := pool.GetConnectionForHost(tcpPoolKey(20000))
+	assert.ErrorIs(t, err, ErrConnectionNotFound)
+}
+
+func TestPoolEvictsClosedConnections(t *testing.T) {
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	conn := newFakeConnection()
+	pool.AddConnection("foo", "", conn)
+
+	conn.Close()
+
+	assert.Eventually(t, func() bool {
+		_, err := pool.GetConnection("foo")
+		return err == ErrConnectionNotFound
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestPoolEvictsOnlyClosedConnectionForHostname(t *testing.T) {
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	closed := newFakeConnection()
+	open := newFakeConnection()
+	pool.AddConnection("foo", "", closed)
+	pool.AddConnection("foo", "", open)
+
+	closed.Close()
+
+	assert.Eventually(t, func() bool {
+		return len(pool.GetConnections("foo")) == 1
+	}, time.Second, 10*time.Millisecond)
+	for i := 0; i < 5; i++ {
+		conn, err := pool.GetConnection("foo")
+		require.NoError(t, err)
+		assert.Equal(t, open, conn)
+	}
+}
+
+func TestPoolRoundRobin(t *testing.T) {
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	conns := []*fakeConnection{newFakeConnection(), newFakeConnection(), newFakeConnection()}
+	for _, conn := range conns {
+		pool.AddConnection("foo", "", conn)
+	}
+
+	counts := make(map[StreamingConnection]int)
+	for i := 0; i < 30; i++ {
+		conn, err := pool.GetConnection("foo")
+		require.NoError(t, err)
+		counts[conn]++
+	}
+	for _, conn := range conns {
+		assert.Equal(t, 10, counts[conn])
+	}
+}
+
+func TestPoolLeastInFlight(t *testing.T) {
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	busy := newFakeConnection()
+	busy.numStreams.Store(5)
+	idle := newFakeConnection()
+	idle.numStreams.Store(1)
+	pool.AddConnection("foo", "", busy)
+	pool.AddConnection("foo", "", idle)
+	require.NoError(t, pool.SetLoadBalancingStrategy("foo", LoadBalancingLeastInFlight))
+
+	for i := 0; i < 5; i++ {
+		conn, err := pool.GetConnection("foo")
+		require.NoError(t, err)
+		assert.Equal(t, idle, conn)
+	}
+
+	idle.numStreams.Store(10)
+	conn, err := pool.GetConnection("foo")
+	require.NoError(t, err)
+	assert.Equal(t, busy, conn)
+}
+
+func TestPoolConsistentHash(t *testing.T) {
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	for i := 0; i < 4; i++ {
+		pool.AddConnection("foo", "", newFakeConnection())
+	}
+	require.NoError(t, pool.SetLoadBalancingStrategy("foo", LoadBalancingConsistentHash))
+
+	// The same client IP always gets the same connection regardless of port
+	selected := make(map[string]StreamingConnection)
+	for i := 0; i < 50; i++ {
+		ip := fmt.Sprintf("10.0.0.%d", i)
+		conn, err := pool.SelectConnection("foo", ip+":1234")
+		require.NoError(t, err)
+		again, err := pool.SelectConnection("foo", ip+":5678")
+		require.NoError(t, err)
+		assert.Equal(t, conn, again)
+		selected[ip] = conn
+	}
+
+	// Adding a connection only moves the clients that now hash to it
+	added := newFakeConnection()
+	pool.AddConnection("foo", "", added)
+	for ip, previous := range selected {
+		conn, err := pool.SelectConnection("foo", ip)
+		require.NoError(t, err)
+		if conn != added {
+			assert.Equal(t, previous, conn)
+		}
+	}
+}
+
+func TestPoolSkipsDrainingConnections(t *testing.T) {
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	draining := newFakeConnection()
+	active := newFakeConnection()
+	pool.AddConnection("foo", "", draining)
+	pool.AddConnection("foo", "", active)
+
+	draining.draining.Store(true)
+	for i := 0; i < 5; i++ {
+		conn, err := pool.GetConnection("foo")
+		require.NoError(t, err)
+		assert.Equal(t, active, conn)
+	}
+
+	active.draining.Store(true)
+	_, err := pool.GetConnection("foo")
+	assert.ErrorIs(t, err, ErrConnectionNotFound)
+}
+
+func TestParseLoadBalancingStrategy(t *testing.T) {
+	strategy, err := ParseLoadBalancingStrategy("")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultLoadBalancingStrategy, strategy)
+
+	strategy, err = ParseLoadBalancingStrategy("least-in-flight")
+	require.NoError(t, err)
+	assert.Equal(t, LoadBalancingLeastInFlight, strategy)
+
+	_, err = ParseLoadBalancingStrategy("random")
+	assert.Error(t, err)
+}
+
+func TestPoolEvents(t *testing.T) {
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+
+	var lock sync.Mutex
+	var events []PoolEvent
+	unsubscribe := pool.Subscribe(func(event PoolEvent) {
+		lock.Lock()
+		defer lock.Unlock()
+		events = append(events, event)
+	})
+
+	conn := newFakeConnection()
+	pool.AddConnection("foo", "", conn)
+	pool.RemoveConnection("foo")
+	pool.RemoveConnection("foo")
+	evicted := newFakeConnection()
+	pool.AddConnection("bar", "", evicted)
+	evicted.Close()
+
+	assert.Eventually(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(events) == 4
+	}, time.Second, 10*time.Millisecond)
+
+	lock.Lock()
+	assert.Equal(t, PoolEvent{Type: PoolEventRegistered, Hostname: "foo", Connection: conn}, events[0])
+	assert.Equal(t, PoolEvent{Type: PoolEventDeregistered, Hostname: "foo", Connection: conn}, events[1])
+	assert.Equal(t, PoolEvent{Type: PoolEventRegistered, Hostname: "bar", Connection: evicted}, events[2])
+	assert.Equal(t, PoolEvent{Type: PoolEventDeregistered, Hostname: "bar", Connection: evicted}, events[3])
+	lock.Unlock()
+
+	unsubscribe()
+	pool.AddConnection("baz", "", newFakeConnection())
+	lock.Lock()
+	assert.Len(t, events, 4)
+	lock.Unlock()
+}
+
+// TestPoolConcurrentAccess is intended to be run with the race detector
+func TestPoolConcurrentAccess(t *testing.T) {
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	// registered holds the connections the pool has announced and not yet withdrawn, it must end up matching
+	// the pool exactly
+	var registeredLock sync.Mutex
+	registered := make(map[StreamingConnection]string)
+	pool.Subscribe(func(event PoolEvent) {
+		registeredLock.Lock()
+		defer registeredLock.Unlock()
+		if event.Type == PoolEventRegistered {
+			registered[event.Connection] = event.Hostname
+		} else {
+			delete(registered, event.Connection)
+		}
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 500; j++ {
+				hostname := fmt.Sprintf("host-%d", j%10)
+				conn := newFakeConnection()
+				switch (i + j) % 5 {
+				case 0:
+					pool.AddConnection(hostname, "", conn)
+				case 1:
+					pool.RemoveConnection(hostname)
+				case 2:
+					pool.AddConnection(hostname, "", conn)
+					conn.Close()
+				case 3:
+					pool.GetConnectionForHost(hostname + ".yuka.dev")
+				default:
+					pool.GetConnection(hostname)
+					pool.Hostnames()
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// Closed connections are evicted in the background, wait for every one of them to be gone
+	require.Eventually(t, func() bool {
+		for _, hostname := range pool.Hostnames() {
+			for _, conn := range pool.GetConnections(hostname) {
+				if !conn.IsOpen() {
+					return false
+				}
+			}
+		}
+		return true
+	}, time.Second, 10*time.Millisecond)
+
+	registeredLock.Lock()
+	defer registeredLock.Unlock()
+	count := 0
+	for _, hostname := range pool.Hostnames() {
+		conns := pool.GetConnections(hostname)
+		require.NotEmpty(t, conns, hostname)
+		for _, conn := range conns {
+			assert.Equal(t, hostname, registered[conn])
+		}
+		count += len(conns)
+
+		conn, err := pool.GetConnection(hostname)
+		require.NoError(t, err)
+		assert.NotNil(t, conn)
+	}
+	assert.Equal(t, len(registered), count)
+}
+
+func TestPoolHostnameOwnership(t *testing.T) {
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	first := newFakeConnection()
+	require.NoError(t, pool.AddConnection("foo", "user:alice", first))
+	require.NoError(t, pool.AddConnection("foo", "user:alice", newFakeConnection()))
+
+	assert.ErrorIs(t, pool.CheckOwner("foo", "user:mallory"), ErrHostnameClaimed)
+	assert.ErrorIs(t, pool.AddConnection("foo", "user:mallory", newFakeConnection()), ErrHostnameClaimed)
+	assert.Len(t, pool.GetConnections("foo"), 2)
+	assert.NoError(t, pool.CheckOwner("bar", "user:mallory"))
+
+	// Once every connection is gone the hostname can be claimed by someone else
+	pool.RemoveConnection("foo")
+	assert.NoError(t, pool.CheckOwner("foo", "user:mallory"))
+	assert.NoError(t, pool.AddConnection("foo", "user:mallory", newFakeConnection()))
+}
@@ -1,24 +1,88 @@
 package streaming_connection
 
-import "io"
+import (
+	"io"
+	"net"
+	"sync"
+	"time"
+)
 
 // StreamingConnection is an interface for handling any type of streaming connection.
+//
+// A single connection is shared by every request to an agent, so data is never written to it directly.
+// Instead each request opens its own Stream which is multiplexed over the connection.
 type StreamingConnection interface {
-	// Write sends data over the connection.
-	Write([]byte) (int, error)
+	// OpenStream opens a new stream to the remote end of the connection.
+	OpenStream() (Stream, error)
 
-	// Read reads data from the connection.
-	Read([]byte) (int, error)
+	// AcceptStream blocks until the remote end of the connection opens a new stream.
+	AcceptStream() (Stream, error)
 
-	// Close closes the connection.
+	// Close closes the connection along with all of its streams.
 	Close() error
 
 	// IsOpen checks if the connection is still open.
 	IsOpen() bool
 
-	// GetReader returns an io.Reader for the connection (for streaming use cases).
-	GetReader() io.Reader
+	// CloseChan returns a channel that is closed once the connection is closed, either locally or because
+	// the remote end went away.
+	CloseChan() <-chan struct{}
 
-	// GetWriter returns an io.Writer for the connection (for streaming use cases).
-	GetWriter() io.Writer
+	// NumStreams returns the number of streams currently open on the connection.
+	NumStreams() int
+
+	// IsDraining checks if the remote end has announced it is shutting down and so shouldn't be sent new streams.
+	IsDraining() bool
+
+	// Latency returns the round trip time measured by the last heartbeat, zero if none has been answered yet.
+	Latency() time.Duration
+
+	// RemoteAddr returns the address of the remote end of the connection.
+	RemoteAddr() net.Addr
+}
+
+// Stream is a single logical bidirectional stream carried over a StreamingConnection.
+type Stream interface {
+	net.Conn
+
+	// CloseWrite half-closes the stream, signalling to the remote end that no more data will be written.
+	CloseWrite() error
+
+	// ID returns the id of the stream, unique within its connection.
+	ID() uint32
+}
+
+// CloseWrite half-closes conn if it supports it, otherwise the connection is closed entirely
+func CloseWrite(conn io.Closer) error {
+	if closeWriter, ok := conn.(interface{ CloseWrite() error }); ok {
+		return closeWriter.CloseWrite()
+	}
+	return conn.Close()
+}
+
+// Splice copies data in both directions between a and b, half-closing each side once there's nothing left
+// to write to it. It blocks until both directions are done and closes a and b before returning.
+func Splice(a io.ReadWriteCloser, b io.ReadWriteCloser) error {
+	errs := make(chan error, 2)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	copyAndCloseWrite := func(dst io.ReadWriteCloser, src io.ReadWriteCloser) {
+		defer wg.Done()
+		_, err := io.Copy(dst, src)
+		CloseWrite(dst)
+		errs <- err
+	}
+	go copyAndCloseWrite(a, b)
+	go copyAndCloseWrite(b, a)
+	wg.Wait()
+	a.Close()
+	b.Close()
+
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -0,0 +1,44 @@
+package streaming_connection
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplice(t *testing.T) {
+	client, clientSide := net.Pipe()
+	server, serverSide := net.Pipe()
+
+	done := make(chan struct{})
+	go func() {
+		Splice(clientSide, serverSide)
+		close(done)
+	}()
+
+	buf := make([]byte, 4)
+	go client.Write([]byte("ping"))
+	_, err := io.ReadFull(server, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "ping", string(buf))
+
+	go server.Write([]byte("pong"))
+	_, err = io.ReadFull(client, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "pong", string(buf))
+
+	// Pipes can't be half-closed so the client going away closes the server side as well
+	client.Close()
+	_, err = server.Read(buf)
+	assert.Equal(t, io.EOF, err)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("splice didn't return once both sides were closed")
+	}
+}
@@ -1,71 +1,65 @@
 package streaming_connection
 
 import (
-	"io"
 	"net"
+
+	"go.uber.org/zap"
 )
 
 // TcpStreamingConnection abstracts a general TCP connection implementing StreamingConnection
 type TcpStreamingConnection struct {
-	tcpConn  net.Conn
-	isOpen   bool
-	metadata *ConnectionMetadata
+	*MuxSession
+	tcpConn net.Conn
+	hello   *Hello
 }
 
-// NewTcpStreamingConnection builds a TcpStreamingConnection and validates the correct metadata is sent before returning.
-// If invalid metadata is sent or no metadata is sent before a given timeout, this will error
-func NewTcpStreamingConnection(tcpConn net.Conn) (*TcpStreamingConnection, error) {
-	conn := TcpStreamingConnection{
-		tcpConn: tcpConn,
-		isOpen:  true,
-	}
-	// We read in the metadata here before returning so we can at least ensure all connections are valid.
-	// Also as the metadata is sent in the first chunk of bytes sent over the wire this prevents any race conditions
-	// of accidentally reading it from elsewhere.
-	metadata, err := ReadMetadataFromNewConnection(&conn)
-	if err != nil {
-		return nil, err
+// NewTcpStreamingConnection builds a TcpStreamingConnection for an agent that has completed the handshake.
+// Everything after the handshake is framed so that requests can be multiplexed over the connection.
+func NewTcpStreamingConnection(logger *zap.Logger, tcpConn net.Conn, hello *Hello, settings SessionSettings) *TcpStreamingConnection {
+	return &TcpStreamingConnection{
+		MuxSession: NewMuxSession(logger, tcpConn, false, settings.MuxConfig()),
+		tcpConn:    tcpConn,
+		hello:      hello,
 	}
-	conn.metadata = metadata
-	return &conn, nil
 }
 
-// Write writes data to the TCP connection.
-func (self *TcpStreamingConnection) Write(data []byte) (int, error) {
-	if !self.IsOpen() {
-		return 0, io.EOF // Return EOF if the connection is closed.
-	}
-	return self.tcpConn.Write(data)
+// ForTunnel returns the connection to add to the pool for the tunnel served on hostname. If the agent
+// serves several tunnels over the connection each stream it opens starts with a StreamHeader so the
+// agent knows which tunnel the stream is for.
+func (self *TcpStreamingConnection) ForTunnel(hostname string) StreamingConnection {
+	return forTunnel(self, self.hello, hostname)
 }
 
-// Read reads data from the TCP connection.
-func (self *TcpStreamingConnection) Read(buffer []byte) (int, error) {
-	if !self.IsOpen() {
-		return 0, io.EOF // Return EOF if the connection is closed.
-	}
-	return self.tcpConn.Read(buffer)
+// agentConnection is a connection from an agent that can be added to the pool for each of its tunnels
+type agentConnection interface {
+	StreamingConnection
+	ForTunnel(hostname string) StreamingConnection
 }
 
-// Close closes the TCP connection.
-func (self *TcpStreamingConnection) Close() error {
-	if self.IsOpen() {
-		self.isOpen = false
-		return self.tcpConn.Close()
+// forTunnel wraps connection so every stream it opens starts with the StreamHeader for hostname, unless
+// the agent that sent hello doesn't expect them
+func forTunnel(connection StreamingConnection, hello *Hello, hostname string) StreamingConnection {
+	if !hello.HasCapability(CapabilityStreamHeaders) {
+		return connection
 	}
-	return nil
+	return &tunnelConnection{StreamingConnection: connection, header: StreamHeader{Hostname: hostname}}
 }
 
-// IsOpen checks if the connection is still open.
-func (self *TcpStreamingConnection) IsOpen() bool {
-	return self.isOpen
+// tunnelConnection is a StreamingConnection that writes a StreamHeader at the start of every stream
+type tunnelConnection struct {
+	StreamingConnection
+	header StreamHeader
 }
 
-// GetReader returns an io.Reader for the connection (for streaming use cases).
-func (self *TcpStreamingConnection) GetReader() io.Reader {
-	return self.tcpConn
-}
-
-// GetWriter returns an io.Writer for the connection (for streaming use cases).
-func (self *TcpStreamingConnection) GetWriter() io.Writer {
-	return self.tcpConn
+// OpenStream opens a new stream and writes the header for the tunnel to it
+func (self *tunnelConnection) OpenStream() (Stream, error) {
+	stream, err := self.StreamingConnection.OpenStream()
+	if err != nil {
+		return nil, err
+	}
+	if err := WriteStreamHeader(stream, &self.header); err != nil {
+		stream.Close()
+		return nil, err
+	}
+	return stream, nil
 }
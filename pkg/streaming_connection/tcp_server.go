@@ -1,14 +1,25 @@
 package streaming_connection
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
 
 	"go.uber.org/zap"
 )
 
+// peekTimeout is how long we wait for a new connection to send enough of its request to be routed
+const peekTimeout = 10 * time.Second
+
 // TcpServer starts up a basic TCP server and supports forwarding those connections on
 type TcpServer struct {
 	slogger        zap.SugaredLogger
@@ -28,14 +39,26 @@ func NewTcpServer(logger *zap.Logger, listenPort int, connectionPool *StreamingC
 //
 // Will close on ctx.Done() being called
 func (self *TcpServer) Listen(ctx context.Context) error {
-	listener, err := net.Listen("tcp", fmt.Sprintf(":%v", self.listenPort))
+	return self.listen(ctx, "TcpTunnel", self.listenPort, self.TunnelRequest)
+}
+
+// ListenTls is a blocking call that starts up the TLS passthrough listener on port, connections are sent to
+// the TLS tunnel for the server name of their ClientHello
+//
+// Will close on ctx.Done() being called
+func (self *TcpServer) ListenTls(ctx context.Context, port int) error {
+	return self.listen(ctx, "TLS passthrough", port, self.TunnelTlsRequest)
+}
+
+func (self *TcpServer) listen(ctx context.Context, name string, port int, handle func(net.Conn) error) error {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%v", port))
 
 	if err != nil {
 		return err
 	}
 	defer listener.Close()
 
-	self.slogger.Infof("TcpTunnel is listening on port %v", self.listenPort)
+	self.slogger.Infof("%s is listening on port %v", name, port)
 
 	// Channel to signal new connections
 	connChan := make(chan net.Conn)
@@ -62,7 +85,7 @@ func (self *TcpServer) Listen(ctx context.Context) error {
 
 		case conn := <-connChan:
 			// Handle the new connection
-			go self.TunnelRequest(conn)
+			go handle(conn)
 
 		case err := <-errChan:
 			// Handle accept error (usually indicates the server should shut down)
@@ -71,39 +94,206 @@ func (self *TcpServer) Listen(ctx context.Context) error {
 	}
 }
 
-func (self *TcpServer) forwardConnection(conn net.Conn, forwardConn StreamingConnection) error {
+// forwardConnection opens a new stream on the connection for conn and copies data between the two
+func (self *TcpServer) forwardConnection(conn net.Conn, connection StreamingConnection) error {
+	return forwardConnection(&self.slogger, conn, connection)
+}
+
+// forwardConnection opens a new stream on connection and copies data between it and conn
+func forwardConnection(slogger *zap.SugaredLogger, conn net.Conn, connection StreamingConnection) error {
+	forwardConn, err := connection.OpenStream()
+	if err != nil {
+		slogger.Errorf("Error opening stream: %v", err)
+		conn.Close()
+		return err
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
 	go func() {
-		self.slogger.Info("Forwarding data from forwardConn to conn.")
+		defer wg.Done()
+		slogger.Info("Forwarding data from forwardConn to conn.")
 		if _, err := io.Copy(conn, forwardConn); err != nil {
-			self.slogger.Errorf("Error copying from forwardConn to conn: %v", err)
+			slogger.Errorf("Error copying from forwardConn to conn: %v", err)
 		}
-		self.slogger.Info("Finished copying data from forwardConn to conn")
-		conn.Close() // Close after copying
+		slogger.Info("Finished copying data from forwardConn to conn")
+		CloseWrite(conn)
 	}()
 	go func() {
-		self.slogger.Info("Forwarding data from conn to forwardConn.")
+		defer wg.Done()
+		slogger.Info("Forwarding data from conn to forwardConn.")
 		if _, err := io.Copy(forwardConn, conn); err != nil {
-			self.slogger.Errorf("Error copying from conn to forwardConn: %v", err)
+			slogger.Errorf("Error copying from conn to forwardConn: %v", err)
 		}
-		self.slogger.Info("Finished copying data from conn to forwardConn")
-		forwardConn.Close() // Close after copying
+		slogger.Info("Finished copying data from conn to forwardConn")
+		forwardConn.CloseWrite()
+	}()
+	go func() {
+		// Each side is only half-closed as it finishes so we need to wait for both before cleaning up
+		wg.Wait()
+		conn.Close()
+		forwardConn.Close()
 	}()
 
 	return nil
 }
 
 func (self *TcpServer) TunnelRequest(conn net.Conn) error {
-	// url := getUrlForRequest(c)
-	registeredHostname := "seb-hostname"
-	// TODO: This should come from the request
-	connection, err := self.connectionPool.GetConnection(registeredHostname)
-	self.slogger.Infof("Got connection for hostname %s", registeredHostname)
+	host, conn, err := readHostFromConnection(conn)
 	if err != nil {
-		self.slogger.Warnf("Received error when getting connection for hostname %s: %v", registeredHostname, err)
+		self.slogger.Warnf("Unable to determine host for connection from %s: %v", conn.RemoteAddr(), err)
+		conn.Close()
+		return err
 	}
 
+	connection, registeredHostname, err := self.connectionPool.SelectConnectionForHost(host, conn.RemoteAddr().String())
+	if err != nil {
+		self.slogger.Warnf("Received error when getting connection for host %s: %v", host, err)
+		writeNotFoundResponse(conn)
+		conn.Close()
+		return err
+	}
+	self.slogger.Infof("Got connection for hostname %s", registeredHostname)
+
 	self.forwardConnection(conn, connection)
 
 	self.slogger.Info("Streaming completed successfully.")
 	return nil
 }
+
+// TunnelTlsRequest forwards a connection to the TLS tunnel for the server name in its ClientHello without
+// decrypting it, the handshake is completed by the agent's service
+func (self *TcpServer) TunnelTlsRequest(conn net.Conn) error {
+	serverName, conn, err := readServerNameFromConnection(conn)
+	if err != nil {
+		self.slogger.Warnf("Unable to determine server name for connection from %s: %v", conn.RemoteAddr(), err)
+		conn.Close()
+		return err
+	}
+
+	connection, registeredHostname, err := self.selectTlsConnection(serverName, conn.RemoteAddr().String())
+	if err != nil {
+		// There's no way to tell the client without completing a handshake, so the connection is just closed
+		self.slogger.Warnf("Received error when getting TLS connection for server name %s: %v", serverName, err)
+		conn.Close()
+		return err
+	}
+	self.slogger.Infof("Got TLS connection for hostname %s", registeredHostname)
+
+	return self.forwardConnection(conn, connection)
+}
+
+// selectTlsConnection resolves the TLS tunnel for serverName the same way HTTP tunnels are resolved for the
+// host of a request, see StreamingConnectionPool.SelectConnectionForHost
+func (self *TcpServer) selectTlsConnection(serverName string, clientAddr string) (StreamingConnection, string, error) {
+	hostname, err := self.connectionPool.CanonicalHostname(serverName)
+	if err != nil {
+		return nil, "", err
+	}
+	conn, err := self.connectionPool.SelectConnection(tlsPoolKey(hostname), clientAddr)
+	if err != nil {
+		return nil, "", err
+	}
+	return conn, hostname, nil
+}
+
+// NewPeekedConn returns conn with reads coming from reader, for when reader has buffered data that was
+// read off conn
+func NewPeekedConn(conn net.Conn, reader io.Reader) net.Conn {
+	return &peekedConn{Conn: conn, reader: reader}
+}
+
+// peekedConn replays bytes that have already been read off a connection before continuing to read from it
+type peekedConn struct {
+	net.Conn
+	reader io.Reader
+}
+
+func (self *peekedConn) Read(b []byte) (int, error) {
+	return self.reader.Read(b)
+}
+
+func (self *peekedConn) CloseWrite() error {
+	return CloseWrite(self.Conn)
+}
+
+// readHostFromConnection reads the Host header from the HTTP request at the start of the connection.
+// The returned connection replays everything that was read so the request can be forwarded untouched.
+func readHostFromConnection(conn net.Conn) (string, net.Conn, error) {
+	var peeked bytes.Buffer
+	if err := conn.SetReadDeadline(time.Now().Add(peekTimeout)); err != nil {
+		return "", conn, err
+	}
+	req, err := http.ReadRequest(bufio.NewReader(io.TeeReader(conn, &peeked)))
+	replayConn := &peekedConn{Conn: conn, reader: io.MultiReader(&peeked, conn)}
+	if err := conn.SetReadDeadline(time.Time{}); err != nil {
+		return "", replayConn, err
+	}
+	if err != nil {
+		return "", replayConn, fmt.Errorf("error reading request: %v", err)
+	}
+	if req.Host == "" {
+		return "", replayConn, errors.New("request has no host")
+	}
+	return req.Host, replayConn, nil
+}
+
+// errClientHelloRead stops the handshake once the ClientHello has been read
+var errClientHelloRead = errors.New("client hello read")
+
+// readServerNameFromConnection reads the server name from the TLS ClientHello at the start of the connection
+// without taking part in the handshake. The returned connection replays everything that was read so the
+// handshake can be forwarded untouched.
+func readServerNameFromConnection(conn net.Conn) (string, net.Conn, error) {
+	var peeked bytes.Buffer
+	if err := conn.SetReadDeadline(time.Now().Add(peekTimeout)); err != nil {
+		return "", conn, err
+	}
+	var serverName string
+	err := tls.Server(&readOnlyConn{Conn: conn, reader: io.TeeReader(conn, &peeked)}, &tls.Config{
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			serverName = hello.ServerName
+			return nil, errClientHelloRead
+		},
+	}).Handshake()
+	replayConn := &peekedConn{Conn: conn, reader: io.MultiReader(&peeked, conn)}
+	if err := conn.SetReadDeadline(time.Time{}); err != nil {
+		return "", replayConn, err
+	}
+	if !errors.Is(err, errClientHelloRead) {
+		return "", replayConn, fmt.Errorf("error reading client hello: %v", err)
+	}
+	if serverName == "" {
+		return "", replayConn, errors.New("client hello has no server name")
+	}
+	return serverName, replayConn, nil
+}
+
+// readOnlyConn lets the ClientHello be read off a connection while discarding anything written to it, i.e
+// the alert sent when the handshake is stopped
+type readOnlyConn struct {
+	net.Conn
+	reader io.Reader
+}
+
+func (self *readOnlyConn) Read(b []byte) (int, error) {
+	return self.reader.Read(b)
+}
+
+func (self *readOnlyConn) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+func writeNotFoundResponse(conn net.Conn) {
+	body := "no tunnel found for host\n"
+	resp := http.Response{
+		StatusCode:    http.StatusNotFound,
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"text/plain"}},
+		ContentLength: int64(len(body)),
+		Body:          io.NopCloser(strings.NewReader(body)),
+		Close:         true,
+	}
+	resp.Write(conn)
+}
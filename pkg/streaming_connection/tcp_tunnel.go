@@ -2,40 +2,106 @@ package streaming_connection
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"errors"
 	"fmt"
+	"math/big"
 	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"yuka/pkg/utils"
 
+	"github.com/gorilla/websocket"
+	"github.com/quic-go/quic-go"
 	"go.uber.org/zap"
 )
 
+const (
+	defaultHeartbeatInterval = 15 * time.Second
+	defaultMaxFrameSize      = 32 * 1024
+
+	randomHostnameLength   = 8
+	randomHostnameAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+)
+
+// TcpTunnelOptions configures a TcpTunnel
+type TcpTunnelOptions struct {
+	ListenPort int
+	// PublicHost is the host the tunnel router is publicly reachable on, HTTP tunnels are served from its subdomains
+	PublicHost string
+	// Authenticator checks the auth token sent by agents, when nil every agent is accepted
+	Authenticator Authenticator
+	// PortAllocator hands out public ports to TCP and UDP tunnels, when nil they're rejected
+	PortAllocator *PortAllocator
+	// PortReleaseGracePeriod is how long the port of a TCP or UDP tunnel stays reserved once its agent goes away
+	PortReleaseGracePeriod time.Duration
+	// UdpSessionIdleTimeout is how long a remote peer of a UDP tunnel can go without sending or receiving a
+	// datagram before its session is closed, 60 seconds when it's 0
+	UdpSessionIdleTimeout time.Duration
+	// MaxUdpSessions is how many remote peers of a UDP tunnel can have a session at once, datagrams from new
+	// peers are dropped while there are this many. 1024 when it's 0.
+	MaxUdpSessions int
+	// TLSConfig secures the connections from agents, when nil they're plaintext
+	TLSConfig *tls.Config
+	// TlsPassthroughPort is the port of the TcpServer's TLS passthrough listener, when 0 TLS tunnels are rejected
+	TlsPassthroughPort int
+	// HttpsPort is the port the tunnel router serves HTTPS on, when set HTTP tunnels are given https URLs on
+	// it rather than http URLs on the public host
+	HttpsPort int
+	// Reservations keeps reserved hostnames for their owners, when nil no hostname is reserved
+	Reservations Reservations
+	// TunnelRecorder is told about every tunnel agents serve, when nil they aren't recorded
+	TunnelRecorder TunnelRecorder
+}
+
 // TcpTunnel is responsible for listening to TCP requests from yukactl clients and then
 // adding those connections to a connection pool. This is required for any TCP streaming
 type TcpTunnel struct {
 	slogger        zap.SugaredLogger
-	listenPort     int
 	connectionPool *StreamingConnectionPool
+	options        TcpTunnelOptions
+
+	// portListeners accept the public connections for TCP tunnels by port and udpListeners receive the
+	// datagrams for UDP tunnels
+	portListenersLock sync.Mutex
+	portListeners     map[int]net.Listener
+	udpListeners      map[int]*udpPortListener
 }
 
-func NewTcpTunnel(logger *zap.Logger, listenPort int, connectionPool *StreamingConnectionPool) *TcpTunnel {
-	return &TcpTunnel{
+func NewTcpTunnel(logger *zap.Logger, connectionPool *StreamingConnectionPool, options TcpTunnelOptions) *TcpTunnel {
+	tunnel := &TcpTunnel{
 		slogger:        *logger.Sugar(),
-		listenPort:     listenPort,
 		connectionPool: connectionPool,
+		options:        options,
+		portListeners:  make(map[int]net.Listener),
+		udpListeners:   make(map[int]*udpPortListener),
 	}
+	if options.PortAllocator != nil {
+		connectionPool.Subscribe(tunnel.handlePoolEvent)
+	}
+	return tunnel
 }
 
 // Listen is a blocking call that starts up the TCP server
 //
 // Will close on ctx.Done() being called
 func (self *TcpTunnel) Listen(ctx context.Context) error {
-	listener, err := net.Listen("tcp", fmt.Sprintf(":%v", self.listenPort))
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%v", self.options.ListenPort))
 
 	if err != nil {
 		return err
 	}
 	defer listener.Close()
-
-	self.slogger.Infof("TcpTunnel is listening on port %v", self.listenPort)
+	if self.options.TLSConfig != nil {
+		listener = tls.NewListener(listener, self.options.TLSConfig)
+		self.slogger.Infof("TcpTunnel is listening on port %v with TLS", self.options.ListenPort)
+	} else {
+		self.slogger.Infof("TcpTunnel is listening on port %v", self.options.ListenPort)
+	}
 
 	// Channel to signal new connections
 	connChan := make(chan net.Conn)
@@ -58,6 +124,7 @@ func (self *TcpTunnel) Listen(ctx context.Context) error {
 		case <-ctx.Done():
 			// The context has been canceled, stop accepting new connections
 			self.slogger.Info("Shutting down tunnel...")
+			self.closePortListeners()
 			return nil
 
 		case conn := <-connChan:
@@ -72,18 +139,502 @@ func (self *TcpTunnel) Listen(ctx context.Context) error {
 }
 
 func (self *TcpTunnel) handleNewConnection(conn net.Conn) error {
-	self.slogger.Infof("Handling new connection")
-	tcpConn, err := NewTcpStreamingConnection(conn)
+	self.slogger.Infof("Handling new connection from %s", conn.RemoteAddr())
+	return self.serveAgent(conn, func(hello *Hello, settings SessionSettings) agentConnection {
+		return NewTcpStreamingConnection(self.slogger.Desugar(), conn, hello, settings)
+	})
+}
+
+// ServeWebSocket serves an agent that connected over a websocket rather than to the tunnel listener. Its
+// tunnels are added to the same pool and served in the same way as those of agents connecting directly.
+func (self *TcpTunnel) ServeWebSocket(ws *websocket.Conn) error {
+	self.slogger.Infof("Handling new websocket connection from %s", ws.RemoteAddr())
+	wsConn := NewWsConn(ws)
+	return self.serveAgent(wsConn, func(hello *Hello, settings SessionSettings) agentConnection {
+		return NewWsStreamingConnection(self.slogger.Desugar(), wsConn, hello, settings)
+	})
+}
+
+// ServeQuic serves an agent that connected over QUIC, the handshake is made on the first stream the agent
+// opens and every request is then sent on a stream of its own
+func (self *TcpTunnel) ServeQuic(conn *quic.Conn) error {
+	self.slogger.Infof("Handling new QUIC connection from %s", conn.RemoteAddr())
+	ctx, cancel := context.WithTimeout(conn.Context(), DefaultHandshakeTimeout)
+	defer cancel()
+	stream, err := conn.AcceptStream(ctx)
+	if err != nil {
+		self.slogger.Warnf("Error accepting control stream from %s: %v", conn.RemoteAddr(), err)
+		conn.CloseWithError(quicErrorCode, "")
+		return err
+	}
+	control := newQuicControlStream(conn, stream)
+	return self.serveAgent(control, func(hello *Hello, settings SessionSettings) agentConnection {
+		return NewQuicStreamingConnection(self.slogger.Desugar(), control, hello, settings)
+	})
+}
+
+// serveAgent completes the handshake with the agent on conn, adding the connection built by newConnection
+// to the pool for each of its tunnels if it's accepted
+func (self *TcpTunnel) serveAgent(conn net.Conn, newConnection func(*Hello, SessionSettings) agentConnection) error {
+	hello, err := ReadHello(conn, DefaultHandshakeTimeout)
+	if err != nil {
+		self.slogger.Warnf("Error reading handshake from %s: %v", conn.RemoteAddr(), err)
+		conn.Close()
+		return err
+	}
+
+	reply, identity, err := self.acceptHello(hello)
 	if err != nil {
-		self.slogger.Errorf("Error occurred when creating new TcpStreamingConnection: %v", err)
+		self.slogger.Warnf("Rejecting connection from %s: %v", conn.RemoteAddr(), err)
+		if err := WriteHelloReply(conn, NewRejectedHelloReply(err.Error()), DefaultHandshakeTimeout); err != nil {
+			self.slogger.Warnf("Error writing handshake reply to %s: %v", conn.RemoteAddr(), err)
+		}
+		conn.Close()
+		return err
+	}
+	if err := WriteHelloReply(conn, reply, DefaultHandshakeTimeout); err != nil {
+		self.slogger.Warnf("Error writing handshake reply to %s: %v", conn.RemoteAddr(), err)
+		self.releaseUnusedPorts(reply.Tunnels)
+		conn.Close()
 		return err
 	}
-	self.slogger.Infof("Created new TcpStreamingConnection")
 
-	fmt.Println("metadata is ", tcpConn.metadata)
-	registeredHostname := tcpConn.metadata.RegisteredHostname
-	self.connectionPool.AddConnection(registeredHostname, tcpConn)
+	agentConn := newConnection(hello, reply.Settings)
+	self.slogger.Infof("Created new %T for agent version %s", agentConn, hello.AgentVersion)
 
-	// return self.forwardConnection(conn)
+	owner := ownerOf(identity)
+	sessions := make([]*TunnelSession, 0, len(hello.Tunnels))
+	for i, tunnel := range hello.Tunnels {
+		hostname := reply.Tunnels[i].Hostname
+		key := poolKey(tunnel.Protocol, hostname)
+		if err := self.connectionPool.AddConnection(key, owner, agentConn.ForTunnel(hostname)); err != nil {
+			// Another agent claimed the hostname after the handshake was accepted, closing the connection
+			// evicts any tunnels that were already added for it
+			self.slogger.Warnf("Error adding connection for hostname %s: %v", hostname, err)
+			agentConn.Close()
+			self.releaseUnusedPorts(reply.Tunnels)
+			return err
+		}
+		if tunnel.LoadBalancingStrategy != "" {
+			self.connectionPool.SetLoadBalancingStrategy(key, LoadBalancingStrategy(tunnel.LoadBalancingStrategy))
+		}
+		sessions = append(sessions, &TunnelSession{
+			Identity:     identity,
+			AgentVersion: hello.AgentVersion,
+			AgentAddress: conn.RemoteAddr().String(),
+			Protocol:     tunnel.Protocol,
+			Hostname:     hostname,
+			PublicURL:    reply.Tunnels[i].PublicURL,
+			StartedAt:    time.Now(),
+		})
+	}
+	if self.options.TunnelRecorder != nil {
+		go self.recordTunnels(agentConn, sessions)
+	}
 	return nil
 }
+
+// recordTunnels tells the TunnelRecorder about the tunnels served by agentConn, and that they've ended once
+// it goes away
+func (self *TcpTunnel) recordTunnels(agentConn agentConnection, sessions []*TunnelSession) {
+	started := make([]*TunnelSession, 0, len(sessions))
+	for _, session := range sessions {
+		if err := self.options.TunnelRecorder.TunnelStarted(session); err != nil {
+			self.slogger.Warnf("Error recording tunnel for hostname %s: %v", session.Hostname, err)
+			continue
+		}
+		started = append(started, session)
+	}
+
+	<-agentConn.CloseChan()
+	for _, session := range started {
+		if err := self.options.TunnelRecorder.TunnelEnded(session); err != nil {
+			self.slogger.Warnf("Error recording end of tunnel for hostname %s: %v", session.Hostname, err)
+		}
+	}
+}
+
+// acceptHello authenticates the agent and validates the tunnels it requested, returning the reply accepting
+// them along with who the agent authenticated as, nil when agents aren't authenticated
+func (self *TcpTunnel) acceptHello(hello *Hello) (*HelloReply, *AgentIdentity, error) {
+	if err := hello.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var identity *AgentIdentity
+	if self.options.Authenticator != nil {
+		var err error
+		identity, err = self.options.Authenticator.Authenticate(hello.AuthToken)
+		if err != nil {
+			self.slogger.Debugf("Error authenticating agent: %v", err)
+			return nil, nil, ErrInvalidAuthToken
+		}
+	}
+	owner := ownerOf(identity)
+
+	reply := &HelloReply{
+		Accepted: true,
+		Settings: SessionSettings{
+			HeartbeatIntervalMs: defaultHeartbeatInterval.Milliseconds(),
+			MaxFrameSize:        defaultMaxFrameSize,
+		},
+	}
+	if hello.HasCapability(CapabilityStreamHeaders) {
+		reply.Capabilities = append(reply.Capabilities, CapabilityStreamHeaders)
+	}
+	if hello.MaxFrameSize > 0 && hello.MaxFrameSize < reply.Settings.MaxFrameSize {
+		reply.Settings.MaxFrameSize = hello.MaxFrameSize
+	}
+
+	for _, tunnel := range hello.Tunnels {
+		if _, err := ParseLoadBalancingStrategy(tunnel.LoadBalancingStrategy); err != nil {
+			self.releaseUnusedPorts(reply.Tunnels)
+			return nil, nil, err
+		}
+
+		var assignment *TunnelAssignment
+		var err error
+		switch tunnel.Protocol {
+		case TunnelProtocolHttp:
+			assignment, err = self.assignHttpTunnel(tunnel, owner)
+		case TunnelProtocolTcp, TunnelProtocolUdp:
+			assignment, err = self.assignPortTunnel(tunnel, owner)
+		case TunnelProtocolTls:
+			assignment, err = self.assignTlsTunnel(tunnel, owner)
+		default:
+			err = fmt.Errorf("unsupported tunnel protocol %q", tunnel.Protocol)
+		}
+		if err == nil && slices.ContainsFunc(reply.Tunnels, func(a TunnelAssignment) bool { return a.Hostname == assignment.Hostname }) {
+			// Streams are sent to the agent's tunnels by hostname, so an HTTP and a TLS tunnel with the same
+			// hostname can't be told apart
+			err = fmt.Errorf("hostname %s is requested by more than one tunnel", assignment.Hostname)
+		}
+		if err != nil {
+			self.releaseUnusedPorts(reply.Tunnels)
+			return nil, nil, err
+		}
+		reply.Tunnels = append(reply.Tunnels, *assignment)
+	}
+	return reply, identity, nil
+}
+
+// ownerOf returns the owner the tunnels of identity are claimed for, empty when agents aren't authenticated
+func ownerOf(identity *AgentIdentity) string {
+	if identity == nil {
+		return ""
+	}
+	return identity.Owner()
+}
+
+// assignHttpTunnel checks the hostname requested by the agent is available, picking one if none was requested
+func (self *TcpTunnel) assignHttpTunnel(tunnel TunnelRequest, owner string) (*TunnelAssignment, error) {
+	hostname, err := self.assignHostname(tunnel, owner)
+	if err != nil {
+		return nil, err
+	}
+	return &TunnelAssignment{
+		Name:      tunnel.Name,
+		Hostname:  hostname,
+		PublicURL: self.publicURL(hostname),
+	}, nil
+}
+
+// assignTlsTunnel checks the hostname requested by the agent is available for a TLS tunnel, which is
+// separate from an HTTP tunnel with the same hostname
+func (self *TcpTunnel) assignTlsTunnel(tunnel TunnelRequest, owner string) (*TunnelAssignment, error) {
+	if self.options.TlsPassthroughPort == 0 {
+		return nil, errors.New("tls tunnels are not enabled")
+	}
+	hostname, err := self.assignHostname(tunnel, owner)
+	if err != nil {
+		return nil, err
+	}
+	return &TunnelAssignment{
+		Name:      tunnel.Name,
+		Hostname:  hostname,
+		PublicURL: fmt.Sprintf("tls://%s", net.JoinHostPort(self.qualifiedHostname(hostname), strconv.Itoa(self.options.TlsPassthroughPort))),
+	}, nil
+}
+
+// assignHostname validates the hostname requested for an HTTP or TLS tunnel, picking one when it's empty.
+// Requested subdomains of the public host are reduced to their canonical hostname before they're checked so
+// "foo.yuka.dev" can't be claimed while "foo" is held by another owner.
+func (self *TcpTunnel) assignHostname(tunnel TunnelRequest, owner string) (string, error) {
+	hostname := strings.ToLower(tunnel.Hostname)
+	var err error
+	if hostname == "" {
+		if hostname, err = self.randomHostname(tunnel.Protocol); err != nil {
+			return "", err
+		}
+	} else if !utils.IsSubdomain(hostname) {
+		return "", fmt.Errorf("invalid tunnel hostname %q", tunnel.Hostname)
+	} else if hostname, err = CanonicalHostname(hostname, self.options.PublicHost); err != nil {
+		return "", fmt.Errorf("invalid tunnel hostname %q", tunnel.Hostname)
+	}
+	if err := self.connectionPool.CheckOwner(poolKey(tunnel.Protocol, hostname), owner); err != nil {
+		return "", fmt.Errorf("%w: %s", err, hostname)
+	}
+	reservedFor, err := self.reservedFor(hostname)
+	if err != nil {
+		return "", err
+	}
+	if reservedFor != "" && reservedFor != owner {
+		return "", fmt.Errorf("%w: %s", ErrHostnameReserved, hostname)
+	}
+	// Anyone could otherwise claim a domain that isn't theirs and have certificates obtained for it
+	if IsCustomDomain(hostname) && reservedFor == "" {
+		return "", fmt.Errorf("%w: %s", ErrHostnameNotReserved, hostname)
+	}
+	return hostname, nil
+}
+
+// reservedFor returns the owner hostname is reserved for, empty if it isn't reserved
+func (self *TcpTunnel) reservedFor(hostname string) (string, error) {
+	if self.options.Reservations == nil {
+		return "", nil
+	}
+	owner, err := self.options.Reservations.ReservedFor(hostname)
+	if err != nil {
+		return "", fmt.Errorf("unable to check reservation of hostname %s: %v", hostname, err)
+	}
+	return owner, nil
+}
+
+// assignPortTunnel reserves a public port for a TCP or UDP tunnel and starts listening on it. Connections
+// and datagrams sent to the port are sent to the connections registered in the pool under the hostname of
+// the assignment.
+func (self *TcpTunnel) assignPortTunnel(tunnel TunnelRequest, owner string) (*TunnelAssignment, error) {
+	if self.options.PortAllocator == nil {
+		return nil, fmt.Errorf("%s tunnels are not enabled", tunnel.Protocol)
+	}
+	port, err := self.options.PortAllocator.Reserve(owner, tunnel.RemotePort)
+	if err != nil {
+		return nil, err
+	}
+	listen, key := self.listenOnPort, tcpPoolKey(port)
+	if tunnel.Protocol == TunnelProtocolUdp {
+		listen, key = self.listenOnUdpPort, udpPoolKey(port)
+	}
+	if err := listen(port); err != nil {
+		if !self.portInUse(port) {
+			self.options.PortAllocator.Release(port)
+		}
+		return nil, fmt.Errorf("unable to listen on port %d: %v", port, err)
+	}
+	return &TunnelAssignment{
+		Name:       tunnel.Name,
+		Hostname:   key,
+		PublicURL:  fmt.Sprintf("%s://%s", tunnel.Protocol, net.JoinHostPort(self.publicHostname(), strconv.Itoa(port))),
+		RemotePort: port,
+	}, nil
+}
+
+// listenOnPort starts accepting public connections on port unless it's already listening
+func (self *TcpTunnel) listenOnPort(port int) error {
+	self.portListenersLock.Lock()
+	defer self.portListenersLock.Unlock()
+	if _, ok := self.portListeners[port]; ok {
+		return nil
+	}
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%v", port))
+	if err != nil {
+		return err
+	}
+	self.portListeners[port] = listener
+	self.slogger.Infof("Listening for TCP tunnel connections on port %v", port)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go self.forwardTcpConnection(port, conn)
+		}
+	}()
+	return nil
+}
+
+// listenOnUdpPort starts receiving the datagrams sent to port unless it's already listening
+func (self *TcpTunnel) listenOnUdpPort(port int) error {
+	self.portListenersLock.Lock()
+	defer self.portListenersLock.Unlock()
+	if _, ok := self.udpListeners[port]; ok {
+		return nil
+	}
+	listener, err := listenUdp(&self.slogger, port, self.connectionPool, self.options.UdpSessionIdleTimeout, self.options.MaxUdpSessions)
+	if err != nil {
+		return err
+	}
+	self.udpListeners[port] = listener
+	self.slogger.Infof("Listening for UDP tunnel datagrams on port %v", port)
+	return nil
+}
+
+// forwardTcpConnection sends a connection made to the public port of a TCP tunnel on to its agent
+func (self *TcpTunnel) forwardTcpConnection(port int, conn net.Conn) {
+	connection, err := self.connectionPool.SelectConnection(tcpPoolKey(port), conn.RemoteAddr().String())
+	if err != nil {
+		// The agent may be reconnecting, it still holds the port but can't serve anything yet
+		self.slogger.Warnf("No connection available for port %d: %v", port, err)
+		conn.Close()
+		return
+	}
+	forwardConnection(&self.slogger, conn, connection)
+}
+
+func (self *TcpTunnel) closePortListener(port int) {
+	self.portListenersLock.Lock()
+	defer self.portListenersLock.Unlock()
+	if listener, ok := self.portListeners[port]; ok {
+		listener.Close()
+		delete(self.portListeners, port)
+	}
+	if listener, ok := self.udpListeners[port]; ok {
+		listener.Close()
+		delete(self.udpListeners, port)
+	}
+}
+
+func (self *TcpTunnel) closePortListeners() {
+	self.portListenersLock.Lock()
+	defer self.portListenersLock.Unlock()
+	for port, listener := range self.portListeners {
+		listener.Close()
+		delete(self.portListeners, port)
+	}
+	for port, listener := range self.udpListeners {
+		listener.Close()
+		delete(self.udpListeners, port)
+	}
+}
+
+// handlePoolEvent starts the grace period of a TCP or UDP tunnel's port once its last agent has gone away
+func (self *TcpTunnel) handlePoolEvent(event PoolEvent) {
+	if event.Type != PoolEventDeregistered {
+		return
+	}
+	if port, ok := parsePortPoolKey(event.Hostname); ok && !self.portInUse(port) {
+		self.releasePort(port)
+	}
+}
+
+// releaseUnusedPorts releases the ports of TCP and UDP tunnels that no agent is serving, used when a
+// handshake fails after ports have been reserved
+func (self *TcpTunnel) releaseUnusedPorts(assignments []TunnelAssignment) {
+	for _, assignment := range assignments {
+		if assignment.RemotePort != 0 && !self.portInUse(assignment.RemotePort) {
+			self.releasePort(assignment.RemotePort)
+		}
+	}
+}
+
+// portInUse reports whether an agent is serving a TCP or UDP tunnel on port, an owner can use the same port
+// for both
+func (self *TcpTunnel) portInUse(port int) bool {
+	return len(self.connectionPool.GetConnections(tcpPoolKey(port))) > 0 ||
+		len(self.connectionPool.GetConnections(udpPoolKey(port))) > 0
+}
+
+func (self *TcpTunnel) releasePort(port int) {
+	self.slogger.Infof("Releasing port %d in %v unless its agent reconnects", port, self.options.PortReleaseGracePeriod)
+	self.options.PortAllocator.ReleaseAfter(port, self.options.PortReleaseGracePeriod, func() {
+		self.closePortListener(port)
+	})
+}
+
+// randomHostname picks a subdomain that isn't reserved or in use by a tunnel of protocol for agents that
+// didn't ask for a specific hostname
+func (self *TcpTunnel) randomHostname(protocol string) (string, error) {
+	for i := 0; i < 10; i++ {
+		b := make([]byte, randomHostnameLength)
+		for j := range b {
+			// rand.Int picks every character equally often, unlike reducing a random byte modulo the alphabet
+			n, err := rand.Int(rand.Reader, big.NewInt(int64(len(randomHostnameAlphabet))))
+			if err != nil {
+				return "", err
+			}
+			b[j] = randomHostnameAlphabet[n.Int64()]
+		}
+		hostname := string(b)
+		if len(self.connectionPool.GetConnections(poolKey(protocol, hostname))) > 0 {
+			continue
+		}
+		reservedFor, err := self.reservedFor(hostname)
+		if err != nil {
+			return "", err
+		}
+		if reservedFor == "" {
+			return hostname, nil
+		}
+	}
+	return "", errors.New("unable to allocate a hostname")
+}
+
+// publicURL returns the URL a tunnel for hostname is reachable on. Hostnames that are already fully
+// qualified are used as is, otherwise they're treated as a subdomain of the public host.
+func (self *TcpTunnel) publicURL(hostname string) string {
+	if self.options.HttpsPort != 0 {
+		host := self.qualifiedHostname(hostname)
+		if self.options.HttpsPort != 443 {
+			host = net.JoinHostPort(host, strconv.Itoa(self.options.HttpsPort))
+		}
+		return fmt.Sprintf("https://%s", host)
+	}
+	if strings.Contains(hostname, ".") {
+		return fmt.Sprintf("http://%s", hostname)
+	}
+	return fmt.Sprintf("http://%s.%s", hostname, self.options.PublicHost)
+}
+
+// qualifiedHostname returns hostname as a subdomain of the public host unless it's already fully qualified
+func (self *TcpTunnel) qualifiedHostname(hostname string) string {
+	if strings.Contains(hostname, ".") {
+		return hostname
+	}
+	return fmt.Sprintf("%s.%s", hostname, self.publicHostname())
+}
+
+// publicHostname returns the public host without its port
+func (self *TcpTunnel) publicHostname() string {
+	if host, _, err := net.SplitHostPort(self.options.PublicHost); err == nil {
+		return host
+	}
+	return self.options.PublicHost
+}
+
+// poolKey returns the name the connections of a tunnel assigned hostname are registered under in the pool.
+// TLS tunnels are kept apart from HTTP tunnels so each listener only routes to the tunnels it serves.
+func poolKey(protocol string, hostname string) string {
+	if protocol == TunnelProtocolTls {
+		return tlsPoolKey(hostname)
+	}
+	return hostname
+}
+
+// tlsPoolKey is the name the connections of the TLS tunnel for hostname are registered under in the pool
+func tlsPoolKey(hostname string) string {
+	return "tls:" + hostname
+}
+
+// tcpPoolKey is the name the connections of the TCP tunnel on port are registered under in the pool
+func tcpPoolKey(port int) string {
+	return fmt.Sprintf("tcp:%d", port)
+}
+
+// udpPoolKey is the name the connections of the UDP tunnel on port are registered under in the pool
+func udpPoolKey(port int) string {
+	return fmt.Sprintf("udp:%d", port)
+}
+
+// parsePortPoolKey returns the port of a TCP or UDP tunnel's pool key
+func parsePortPoolKey(key string) (int, bool) {
+	portStr, ok := strings.CutPrefix(key, "tcp:")
+	if !ok {
+		if portStr, ok = strings.CutPrefix(key, "udp:"); !ok {
+			return 0, false
+		}
+	}
+	port, err := strconv.Atoi(portStr)
+	return port, err == nil
+}
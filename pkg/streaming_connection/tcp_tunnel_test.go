@@ -0,0 +1,471 @@
+package streaming_connection
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+	"yuka/pkg/tls_helper"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type fakeAuthenticator map[string]*AgentIdentity
+
+func (f fakeAuthenticator) Authenticate(token string) (*AgentIdentity, error) {
+	if identity, ok := f[token]; ok {
+		return identity, nil
+	}
+	return nil, ErrInvalidAuthToken
+}
+
+// echoTunnel reads the StreamHeader from each stream, sending the hostname on hostnames, before echoing
+// the rest of the stream
+func echoTunnel(session StreamingConnection, hostnames chan<- string) {
+	for {
+		stream, err := session.AcceptStream()
+		if err != nil {
+			return
+		}
+		go func() {
+			header, err := ReadStreamHeader(stream, time.Second)
+			if err != nil {
+				stream.Close()
+				return
+			}
+			hostnames <- header.Hostname
+			io.Copy(stream, stream)
+			stream.CloseWrite()
+		}()
+	}
+}
+
+func TestTcpTunnelAuthenticatesAgents(t *testing.T) {
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	tunnel := NewTcpTunnel(zap.NewNop(), pool, TcpTunnelOptions{
+		PublicHost: "yuka.dev",
+		Authenticator: fakeAuthenticator{
+			"alice-token":   {UserID: "alice"},
+			"mallory-token": {UserID: "mallory"},
+			"bob-token":     {UserID: "bob", OrganizationID: "acme"},
+		},
+	})
+	tunnels := []TunnelRequest{{Protocol: TunnelProtocolHttp, Hostname: "Foo"}}
+
+	_, _, err := tunnel.acceptHello(NewHello("dev", "", tunnels))
+	assert.ErrorIs(t, err, ErrInvalidAuthToken)
+	_, _, err = tunnel.acceptHello(NewHello("dev", "wrong-token", tunnels))
+	assert.ErrorIs(t, err, ErrInvalidAuthToken)
+
+	reply, identity, err := tunnel.acceptHello(NewHello("dev", "alice-token", tunnels))
+	require.NoError(t, err)
+	assert.Equal(t, "user:alice", identity.Owner())
+	assert.Equal(t, "http://foo.yuka.dev", reply.Tunnels[0].PublicURL)
+	require.NoError(t, pool.AddConnection(reply.Tunnels[0].Hostname, identity.Owner(), newFakeConnection()))
+
+	// Another user can't claim the hostname while alice is serving it
+	_, _, err = tunnel.acceptHello(NewHello("dev", "mallory-token", tunnels))
+	assert.ErrorIs(t, err, ErrHostnameClaimed)
+	_, _, err = tunnel.acceptHello(NewHello("dev", "alice-token", tunnels))
+	assert.NoError(t, err)
+
+	_, identity, err = tunnel.acceptHello(NewHello("dev", "bob-token", []TunnelRequest{{Protocol: TunnelProtocolHttp, Hostname: "bar"}}))
+	require.NoError(t, err)
+	assert.Equal(t, "organization:acme", identity.Owner())
+}
+
+func TestTcpTunnelGivesHttpsURLs(t *testing.T) {
+	tunnels := []TunnelRequest{{Protocol: TunnelProtocolHttp, Hostname: "foo"}}
+	tunnel := NewTcpTunnel(zap.NewNop(), NewStreamingConnectionPool(zap.NewNop(), "yuka.dev"), TcpTunnelOptions{
+		PublicHost: "yuka.dev",
+		HttpsPort:  443,
+	})
+	reply, _, err := tunnel.acceptHello(NewHello("dev", "", tunnels))
+	require.NoError(t, err)
+	assert.Equal(t, "https://foo.yuka.dev", reply.Tunnels[0].PublicURL)
+
+	// The port of the public host is the one of the HTTP listener
+	tunnel = NewTcpTunnel(zap.NewNop(), NewStreamingConnectionPool(zap.NewNop(), "localhost:8081"), TcpTunnelOptions{
+		PublicHost: "localhost:8081",
+		HttpsPort:  8443,
+	})
+	reply, _, err = tunnel.acceptHello(NewHello("dev", "", tunnels))
+	require.NoError(t, err)
+	assert.Equal(t, "https://foo.localhost:8443", reply.Tunnels[0].PublicURL)
+}
+
+func TestTcpTunnelAssignsHostnames(t *testing.T) {
+	tunnel := NewTcpTunnel(zap.NewNop(), NewStreamingConnectionPool(zap.NewNop(), "yuka.dev"), TcpTunnelOptions{PublicHost: "yuka.dev"})
+
+	reply, _, err := tunnel.acceptHello(NewHello("dev", "", []TunnelRequest{{Protocol: TunnelProtocolHttp}}))
+	require.NoError(t, err)
+	hostname := reply.Tunnels[0].Hostname
+	assert.Regexp(t, `^[a-z0-9]{8}$`, hostname)
+	assert.Equal(t, "http://"+hostname+".yuka.dev", reply.Tunnels[0].PublicURL)
+
+	for _, invalid := range []string{"foo_bar", "-foo", "foo..bar", "foo bar"} {
+		_, _, err := tunnel.acceptHello(NewHello("dev", "", []TunnelRequest{{Protocol: TunnelProtocolHttp, Hostname: invalid}}))
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestTcpTunnelCanonicalizesRequestedHostnames(t *testing.T) {
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	tunnel := NewTcpTunnel(zap.NewNop(), pool, TcpTunnelOptions{
+		PublicHost: "yuka.dev",
+		Authenticator: fakeAuthenticator{
+			"alice-token":   {UserID: "alice"},
+			"mallory-token": {UserID: "mallory"},
+		},
+		TlsPassthroughPort: 8087,
+	})
+
+	for _, protocol := range []string{TunnelProtocolHttp, TunnelProtocolTls} {
+		reply, identity, err := tunnel.acceptHello(NewHello("dev", "alice-token", []TunnelRequest{{Protocol: protocol, Hostname: "foo"}}))
+		require.NoError(t, err, protocol)
+		require.NoError(t, pool.AddConnection(poolKey(protocol, reply.Tunnels[0].Hostname), identity.Owner(), newFakeConnection()))
+
+		// The qualified forms of alice's hostname resolve to the same tunnel so mallory can't claim them
+		for _, hostname := range []string{"foo.yuka.dev", "FOO.yuka.dev"} {
+			_, _, err := tunnel.acceptHello(NewHello("dev", "mallory-token", []TunnelRequest{{Protocol: protocol, Hostname: hostname}}))
+			assert.ErrorIs(t, err, ErrHostnameClaimed, hostname)
+		}
+		reply, _, err = tunnel.acceptHello(NewHello("dev", "alice-token", []TunnelRequest{{Protocol: protocol, Hostname: "foo.yuka.dev"}}))
+		require.NoError(t, err, protocol)
+		assert.Equal(t, "foo", reply.Tunnels[0].Hostname, protocol)
+	}
+
+	for _, invalid := range []string{"yuka.dev", "bar.foo.yuka.dev"} {
+		_, _, err := tunnel.acceptHello(NewHello("dev", "mallory-token", []TunnelRequest{{Protocol: TunnelProtocolHttp, Hostname: invalid}}))
+		assert.Error(t, err, invalid)
+	}
+}
+
+type fakeReservations map[string]string
+
+func (f fakeReservations) ReservedFor(hostname string) (string, error) {
+	return f[hostname], nil
+}
+
+func TestTcpTunnelKeepsReservedHostnames(t *testing.T) {
+	tunnel := NewTcpTunnel(zap.NewNop(), NewStreamingConnectionPool(zap.NewNop(), "yuka.dev"), TcpTunnelOptions{
+		PublicHost: "yuka.dev",
+		Authenticator: fakeAuthenticator{
+			"alice-token": {UserID: "alice"},
+			"bob-token":   {UserID: "bob", OrganizationID: "acme"},
+		},
+		Reservations: fakeReservations{
+			"acme":         OrganizationOwner("acme"),
+			"app.acme.com": OrganizationOwner("acme"),
+		},
+		TlsPassthroughPort: 8087,
+	})
+
+	for _, protocol := range []string{TunnelProtocolHttp, TunnelProtocolTls} {
+		for _, hostname := range []string{"acme", "ACME.yuka.dev", "app.acme.com"} {
+			tunnels := []TunnelRequest{{Protocol: protocol, Hostname: hostname}}
+			_, _, err := tunnel.acceptHello(NewHello("dev", "alice-token", tunnels))
+			assert.ErrorIs(t, err, ErrHostnameReserved, protocol+" "+hostname)
+			_, _, err = tunnel.acceptHello(NewHello("dev", "bob-token", tunnels))
+			assert.NoError(t, err, protocol+" "+hostname)
+		}
+
+		// Custom domains nobody has reserved aren't handed out
+		_, _, err := tunnel.acceptHello(NewHello("dev", "bob-token", []TunnelRequest{{Protocol: protocol, Hostname: "app.other.com"}}))
+		assert.ErrorIs(t, err, ErrHostnameNotReserved, protocol)
+	}
+
+	// Unreserved subdomains are still handed out to anyone
+	_, _, err := tunnel.acceptHello(NewHello("dev", "alice-token", []TunnelRequest{{Protocol: TunnelProtocolHttp, Hostname: "other"}}))
+	assert.NoError(t, err)
+}
+
+type fakeTunnelRecorder struct {
+	started chan *TunnelSession
+	ended   chan *TunnelSession
+}
+
+func (f *fakeTunnelRecorder) TunnelStarted(session *TunnelSession) error {
+	session.ID = session.Hostname
+	f.started <- session
+	return nil
+}
+
+func (f *fakeTunnelRecorder) TunnelEnded(session *TunnelSession) error {
+	f.ended <- session
+	return nil
+}
+
+func TestTcpTunnelRecordsTunnels(t *testing.T) {
+	recorder := &fakeTunnelRecorder{started: make(chan *TunnelSession, 2), ended: make(chan *TunnelSession, 2)}
+	tunnel := NewTcpTunnel(zap.NewNop(), NewStreamingConnectionPool(zap.NewNop(), "yuka.dev"), TcpTunnelOptions{
+		PublicHost:     "yuka.dev",
+		Authenticator:  fakeAuthenticator{"bob-token": {UserID: "bob", OrganizationID: "acme"}},
+		TunnelRecorder: recorder,
+	})
+
+	agentConn, serverConn := net.Pipe()
+	go tunnel.handleNewConnection(serverConn)
+	hello := NewHello("dev", "bob-token", []TunnelRequest{{Protocol: TunnelProtocolHttp, Hostname: "foo"}, {Protocol: TunnelProtocolHttp, Hostname: "bar"}})
+	reply, err := ClientHandshake(agentConn, hello, time.Second)
+	require.NoError(t, err)
+	agent := NewMuxSession(zap.NewNop(), agentConn, true, reply.Settings.MuxConfig())
+
+	for _, hostname := range []string{"foo", "bar"} {
+		session := <-recorder.started
+		assert.Equal(t, hostname, session.Hostname)
+		assert.Equal(t, TunnelProtocolHttp, session.Protocol)
+		assert.Equal(t, "http://"+hostname+".yuka.dev", session.PublicURL)
+		assert.Equal(t, "dev", session.AgentVersion)
+		assert.Equal(t, "organization:acme", session.Identity.Owner())
+	}
+	assert.Empty(t, recorder.ended)
+
+	// Once the agent goes away its tunnels end
+	agent.Close()
+	for _, hostname := range []string{"foo", "bar"} {
+		select {
+		case session := <-recorder.ended:
+			assert.Equal(t, hostname, session.ID)
+		case <-time.After(time.Second):
+			t.Fatalf("tunnel %s was not ended", hostname)
+		}
+	}
+}
+
+func TestTcpTunnelForwardsAllocatedPort(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	freePort := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	allocator := NewPortAllocator(zap.NewNop(), freePort, freePort, 0)
+	tunnel := NewTcpTunnel(zap.NewNop(), pool, TcpTunnelOptions{
+		PublicHost:             "yuka.dev:8081",
+		PortAllocator:          allocator,
+		PortReleaseGracePeriod: 50 * time.Millisecond,
+	})
+
+	agentConn, serverConn := net.Pipe()
+	go tunnel.handleNewConnection(serverConn)
+	reply, err := ClientHandshake(agentConn, NewHello("dev", "", []TunnelRequest{{Protocol: TunnelProtocolTcp}}), time.Second)
+	require.NoError(t, err)
+	require.Len(t, reply.Tunnels, 1)
+	assert.Equal(t, freePort, reply.Tunnels[0].RemotePort)
+	assert.Equal(t, fmt.Sprintf("tcp://yuka.dev:%d", freePort), reply.Tunnels[0].PublicURL)
+
+	assert.True(t, reply.HasCapability(CapabilityStreamHeaders))
+
+	agent := NewMuxSession(zap.NewNop(), agentConn, true, reply.Settings.MuxConfig())
+	hostnames := make(chan string, 1)
+	go echoTunnel(agent, hostnames)
+	require.Eventually(t, func() bool { return len(pool.GetConnections(reply.Tunnels[0].Hostname)) == 1 }, time.Second, 10*time.Millisecond)
+
+	conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", freePort))
+	require.NoError(t, err)
+	_, err = conn.Write([]byte("hello"))
+	require.NoError(t, err)
+	require.NoError(t, conn.(*net.TCPConn).CloseWrite())
+	resp, err := io.ReadAll(conn)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(resp))
+	assert.Equal(t, reply.Tunnels[0].Hostname, <-hostnames)
+	conn.Close()
+
+	// Once the agent goes away the port is released after the grace period
+	agent.Close()
+	require.Eventually(t, func() bool { return len(allocator.Reservations()) == 0 }, time.Second, 10*time.Millisecond)
+	_, err = net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", freePort))
+	assert.Error(t, err)
+}
+
+func TestTcpStreamingConnectionForTunnel(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		capabilities []string
+		header       bool
+	}{
+		{name: "stream headers", capabilities: []string{CapabilityMultiplexing, CapabilityStreamHeaders}, header: true},
+		{name: "legacy agent", capabilities: []string{CapabilityMultiplexing}, header: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			agentConn, serverConn := net.Pipe()
+			hello := &Hello{ProtocolVersion: ProtocolVersion, Capabilities: tc.capabilities}
+			tcpConn := NewTcpStreamingConnection(zap.NewNop(), serverConn, hello, SessionSettings{})
+			defer tcpConn.Close()
+			agent := NewMuxSession(zap.NewNop(), agentConn, true, nil)
+			defer agent.Close()
+
+			go func() {
+				stream, err := tcpConn.ForTunnel("foo").OpenStream()
+				if err != nil {
+					return
+				}
+				stream.Write([]byte("hello"))
+				stream.CloseWrite()
+			}()
+
+			stream, err := agent.AcceptStream()
+			require.NoError(t, err)
+			if tc.header {
+				header, err := ReadStreamHeader(stream, time.Second)
+				require.NoError(t, err)
+				assert.Equal(t, "foo", header.Hostname)
+			}
+			b, err := io.ReadAll(stream)
+			require.NoError(t, err)
+			assert.Equal(t, "hello", string(b))
+		})
+	}
+}
+
+func TestTcpTunnelServesTlsAgents(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	ca, err := tls_helper.NewCA("yuka test")
+	require.NoError(t, err)
+	serverCertificate, err := ca.IssueServerCertificate("127.0.0.1")
+	require.NoError(t, err)
+	agentCertificate, err := ca.IssueClientCertificate("agent")
+	require.NoError(t, err)
+
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	tunnel := NewTcpTunnel(zap.NewNop(), pool, TcpTunnelOptions{
+		ListenPort: port,
+		PublicHost: "yuka.dev",
+		TLSConfig: &tls.Config{
+			Certificates: []tls.Certificate{serverCertificate},
+			ClientCAs:    ca.CertPool(),
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+		},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go tunnel.Listen(ctx)
+
+	address := fmt.Sprintf("127.0.0.1:%d", port)
+	register := func(config *tls.Config) (net.Conn, *HelloReply, error) {
+		var conn net.Conn
+		require.Eventually(t, func() bool {
+			conn, err = tls.Dial("tcp", address, config)
+			return err == nil || !errors.Is(err, syscall.ECONNREFUSED)
+		}, time.Second, 10*time.Millisecond)
+		if err != nil {
+			return nil, nil, err
+		}
+		reply, err := ClientHandshake(conn, NewHello("dev", "", []TunnelRequest{{Protocol: TunnelProtocolHttp, Hostname: "foo"}}), time.Second)
+		if err != nil {
+			conn.Close()
+		}
+		return conn, reply, err
+	}
+
+	// Agents must verify the server and present a certificate of their own
+	_, _, err = register(&tls.Config{})
+	assert.ErrorContains(t, err, "certificate signed by unknown authority")
+	_, _, err = register(&tls.Config{RootCAs: ca.CertPool()})
+	assert.ErrorContains(t, err, "certificate required")
+
+	conn, reply, err := register(&tls.Config{RootCAs: ca.CertPool(), Certificates: []tls.Certificate{agentCertificate}})
+	require.NoError(t, err)
+	agent := NewMuxSession(zap.NewNop(), conn, true, reply.Settings.MuxConfig())
+	defer agent.Close()
+	hostnames := make(chan string, 1)
+	go echoTunnel(agent, hostnames)
+
+	require.Eventually(t, func() bool { return len(pool.GetConnections("foo")) == 1 }, time.Second, 10*time.Millisecond)
+	connection, _, err := pool.SelectConnectionForHost("foo.yuka.dev", "127.0.0.1")
+	require.NoError(t, err)
+	stream, err := connection.OpenStream()
+	require.NoError(t, err)
+	_, err = stream.Write([]byte("hello"))
+	require.NoError(t, err)
+	require.NoError(t, stream.CloseWrite())
+	b, err := io.ReadAll(stream)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(b))
+	assert.Equal(t, "foo", <-hostnames)
+}
+
+func TestTcpServerPassesTlsThrough(t *testing.T) {
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	tunnel := NewTcpTunnel(zap.NewNop(), pool, TcpTunnelOptions{PublicHost: "yuka.dev:8081", TlsPassthroughPort: 8087})
+	server := NewTcpServer(zap.NewNop(), 0, pool)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.TunnelTlsRequest(conn)
+		}
+	}()
+
+	_, _, err = tunnel.acceptHello(NewHello("dev", "", []TunnelRequest{{Protocol: TunnelProtocolHttp, Hostname: "foo"}, {Protocol: TunnelProtocolTls, Hostname: "foo"}}))
+	assert.Error(t, err)
+
+	agentConn, serverConn := net.Pipe()
+	go tunnel.handleNewConnection(serverConn)
+	reply, err := ClientHandshake(agentConn, NewHello("dev", "", []TunnelRequest{{Protocol: TunnelProtocolTls, Hostname: "foo"}}), time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", reply.Tunnels[0].Hostname)
+	assert.Equal(t, "tls://foo.yuka.dev:8087", reply.Tunnels[0].PublicURL)
+	// An HTTP tunnel with the same hostname is a different tunnel
+	require.NoError(t, pool.AddConnection("foo", "", newFakeConnection()))
+
+	// The agent's service terminates TLS itself
+	ca, err := tls_helper.NewCA("yuka test")
+	require.NoError(t, err)
+	certificate, err := ca.IssueServerCertificate("foo.yuka.dev")
+	require.NoError(t, err)
+	agent := NewMuxSession(zap.NewNop(), agentConn, true, reply.Settings.MuxConfig())
+	t.Cleanup(func() { agent.Close() })
+	go func() {
+		for {
+			stream, err := agent.AcceptStream()
+			if err != nil {
+				return
+			}
+			go func() {
+				if _, err := ReadStreamHeader(stream, time.Second); err != nil {
+					stream.Close()
+					return
+				}
+				conn := tls.Server(stream, &tls.Config{Certificates: []tls.Certificate{certificate}})
+				io.Copy(conn, conn)
+				conn.Close()
+			}()
+		}
+	}()
+	require.Eventually(t, func() bool { return len(pool.GetConnections(tlsPoolKey("foo"))) == 1 }, time.Second, 10*time.Millisecond)
+
+	conn, err := tls.Dial("tcp", listener.Addr().String(), &tls.Config{ServerName: "foo.yuka.dev", RootCAs: ca.CertPool()})
+	require.NoError(t, err)
+	_, err = conn.Write([]byte("hello"))
+	require.NoError(t, err)
+	b := make([]byte, 5)
+	_, err = io.ReadFull(conn, b)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(b))
+	conn.Close()
+
+	// Connections for server names without a TLS tunnel are closed without a handshake
+	_, err = tls.Dial("tcp", listener.Addr().String(), &tls.Config{ServerName: "bar.yuka.dev", RootCAs: ca.CertPool()})
+	assert.Error(t, err)
+	_, err = tls.Dial("tcp", listener.Addr().String(), &tls.Config{InsecureSkipVerify: true})
+	assert.Error(t, err)
+}
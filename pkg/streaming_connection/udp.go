@@ -0,0 +1,281 @@
+package streaming_connection
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+/*
+ * Datagrams sent to the public port of a UDP tunnel are carried to the agent over a stream per remote peer.
+ * Each datagram is written to the stream as a 2 byte big endian length followed by the datagram, so it can
+ * be sent on as the same datagram at the other end. Replies from the agent's service come back the same way.
+ **/
+
+const (
+	// MaxDatagramSize is the largest datagram that can be carried by a UDP tunnel
+	MaxDatagramSize = 65535
+
+	defaultUdpSessionIdleTimeout = 60 * time.Second
+	defaultMaxUdpSessions        = 1024
+	// udpSessionQueueSize is how many datagrams can wait to be written to a session's stream before new ones
+	// are dropped, the same as a full socket buffer would
+	udpSessionQueueSize = 64
+)
+
+var (
+	ErrDatagramTooLarge   = errors.New("datagram too large")
+	ErrTooManyUdpSessions = errors.New("too many UDP sessions")
+)
+
+// WriteDatagram writes datagram to w as a single frame
+func WriteDatagram(w io.Writer, datagram []byte) error {
+	if len(datagram) > MaxDatagramSize {
+		return ErrDatagramTooLarge
+	}
+	frame := make([]byte, 2+len(datagram))
+	binary.BigEndian.PutUint16(frame, uint16(len(datagram)))
+	copy(frame[2:], datagram)
+	_, err := w.Write(frame)
+	return err
+}
+
+// ReadDatagram reads the next frame from r into buf, returning the size of the datagram. buf should be
+// MaxDatagramSize bytes to fit any datagram.
+func ReadDatagram(r io.Reader, buf []byte) (int, error) {
+	var length [2]byte
+	if _, err := io.ReadFull(r, length[:]); err != nil {
+		return 0, err
+	}
+	n := int(binary.BigEndian.Uint16(length[:]))
+	if n > len(buf) {
+		return 0, ErrDatagramTooLarge
+	}
+	if _, err := io.ReadFull(r, buf[:n]); err != nil {
+		if err == io.EOF {
+			return 0, io.ErrUnexpectedEOF
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// udpPortListener receives the datagrams sent to the public port of a UDP tunnel. Each remote peer gets a
+// session with a stream of its own to the agent, so replies can be sent back to the peer they're for.
+type udpPortListener struct {
+	slogger        *zap.SugaredLogger
+	port           int
+	conn           net.PacketConn
+	connectionPool *StreamingConnectionPool
+	idleTimeout    time.Duration
+	// maxSessions is how many remote peers can have a session at once, datagrams from new peers are dropped
+	// while there are this many
+	maxSessions int
+
+	sessionsLock sync.Mutex
+	sessions     map[string]*udpSession
+}
+
+// udpSession is the stream to the agent for a remote peer, it's closed once the peer has been idle for the
+// listener's idle timeout
+type udpSession struct {
+	addr net.Addr
+	// stream is only set once it has been opened, by the goroutine that then serves the session
+	stream    Stream
+	datagrams chan []byte
+	// lastActive is when a datagram was last sent or received in unix nanoseconds
+	lastActive atomic.Int64
+	closeOnce  sync.Once
+	closed     chan struct{}
+}
+
+func (self *udpSession) touch() {
+	self.lastActive.Store(time.Now().UnixNano())
+}
+
+func (self *udpSession) idle() time.Duration {
+	return time.Since(time.Unix(0, self.lastActive.Load()))
+}
+
+func listenUdp(logger *zap.SugaredLogger, port int, connectionPool *StreamingConnectionPool, idleTimeout time.Duration, maxSessions int) (*udpPortListener, error) {
+	conn, err := net.ListenPacket("udp", fmt.Sprintf(":%v", port))
+	if err != nil {
+		return nil, err
+	}
+	if idleTimeout <= 0 {
+		idleTimeout = defaultUdpSessionIdleTimeout
+	}
+	if maxSessions <= 0 {
+		maxSessions = defaultMaxUdpSessions
+	}
+	listener := &udpPortListener{
+		slogger:        logger,
+		port:           port,
+		conn:           conn,
+		connectionPool: connectionPool,
+		idleTimeout:    idleTimeout,
+		maxSessions:    maxSessions,
+		sessions:       make(map[string]*udpSession),
+	}
+	go listener.serve()
+	return listener, nil
+}
+
+// Close stops receiving datagrams and closes every session
+func (self *udpPortListener) Close() error {
+	return self.conn.Close()
+}
+
+func (self *udpPortListener) serve() {
+	defer self.closeSessions()
+
+	buf := make([]byte, MaxDatagramSize)
+	for {
+		n, addr, err := self.conn.ReadFrom(buf)
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				self.slogger.Errorf("Error reading datagram on port %d: %v", self.port, err)
+			}
+			return
+		}
+		session, err := self.session(addr)
+		if errors.Is(err, ErrTooManyUdpSessions) {
+			self.slogger.Debugf("Dropping datagram from %s on port %d, there are too many sessions", addr, self.port)
+			continue
+		} else if err != nil {
+			// The agent may be reconnecting, it still holds the port but can't serve anything yet
+			self.slogger.Warnf("No connection available for datagram from %s on port %d: %v", addr, self.port, err)
+			continue
+		}
+		session.touch()
+		select {
+		case session.datagrams <- append([]byte(nil), buf[:n]...):
+		default:
+			self.slogger.Debugf("Dropping datagram from %s on port %d, its session is backed up", addr, self.port)
+		}
+	}
+}
+
+// session returns the session for addr, starting one if it doesn't have one. The stream to the agent is opened
+// by the session so datagrams from other peers aren't held up while it's opened, those from addr are queued.
+func (self *udpPortListener) session(addr net.Addr) (*udpSession, error) {
+	self.sessionsLock.Lock()
+	defer self.sessionsLock.Unlock()
+	if session, ok := self.sessions[addr.String()]; ok {
+		return session, nil
+	}
+	if len(self.sessions) >= self.maxSessions {
+		return nil, ErrTooManyUdpSessions
+	}
+
+	connection, err := self.connectionPool.SelectConnection(udpPoolKey(self.port), addr.String())
+	if err != nil {
+		return nil, err
+	}
+	session := &udpSession{
+		addr:      addr,
+		datagrams: make(chan []byte, udpSessionQueueSize),
+		closed:    make(chan struct{}),
+	}
+	session.touch()
+	self.sessions[addr.String()] = session
+
+	go self.serveSession(session, connection)
+	return session, nil
+}
+
+// serveSession opens the stream for session to the agent on connection then carries its datagrams
+func (self *udpPortListener) serveSession(session *udpSession, connection StreamingConnection) {
+	stream, err := connection.OpenStream()
+	if err != nil {
+		self.slogger.Warnf("Error opening stream for %s on port %d: %v", session.addr, self.port, err)
+		self.closeSession(session)
+		return
+	}
+	session.stream = stream
+	self.slogger.Debugf("Opened UDP session for %s on port %d", session.addr, self.port)
+
+	go self.readDatagrams(session)
+	self.writeDatagrams(session)
+}
+
+// writeDatagrams sends the datagrams from the peer to the agent until the session is closed or goes idle, then
+// closes the stream
+func (self *udpPortListener) writeDatagrams(session *udpSession) {
+	defer session.stream.Close()
+	defer self.closeSession(session)
+
+	timer := time.NewTimer(self.idleTimeout)
+	defer timer.Stop()
+	for {
+		select {
+		case datagram := <-session.datagrams:
+			if err := WriteDatagram(session.stream, datagram); err != nil {
+				self.slogger.Warnf("Error writing datagram from %s to stream: %v", session.addr, err)
+				return
+			}
+		case <-timer.C:
+			if idle := session.idle(); idle < self.idleTimeout {
+				timer.Reset(self.idleTimeout - idle)
+				continue
+			}
+			self.slogger.Debugf("UDP session for %s on port %d is idle", session.addr, self.port)
+			return
+		case <-session.closed:
+			return
+		}
+	}
+}
+
+// readDatagrams sends the datagrams from the agent back to the peer until the stream is closed
+func (self *udpPortListener) readDatagrams(session *udpSession) {
+	defer self.closeSession(session)
+
+	buf := make([]byte, MaxDatagramSize)
+	for {
+		n, err := ReadDatagram(session.stream, buf)
+		if err != nil {
+			if err != io.EOF {
+				self.slogger.Debugf("Error reading datagram for %s from stream: %v", session.addr, err)
+			}
+			return
+		}
+		if _, err := self.conn.WriteTo(buf[:n], session.addr); err != nil {
+			self.slogger.Warnf("Error writing datagram to %s: %v", session.addr, err)
+			return
+		}
+		session.touch()
+	}
+}
+
+func (self *udpPortListener) closeSession(session *udpSession) {
+	session.closeOnce.Do(func() {
+		self.sessionsLock.Lock()
+		if self.sessions[session.addr.String()] == session {
+			delete(self.sessions, session.addr.String())
+		}
+		self.sessionsLock.Unlock()
+		// writeDatagrams closes the stream once it sees the session is closed
+		close(session.closed)
+		self.slogger.Debugf("Closed UDP session for %s on port %d", session.addr, self.port)
+	})
+}
+
+func (self *udpPortListener) closeSessions() {
+	self.sessionsLock.Lock()
+	sessions := make([]*udpSession, 0, len(self.sessions))
+	for _, session := range self.sessions {
+		sessions = append(sessions, session)
+	}
+	self.sessionsLock.Unlock()
+	for _, session := range sessions {
+		self.closeSession(session)
+	}
+}
@@ -0,0 +1,285 @@
+package streaming_connection
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestWriteAndReadDatagram(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteDatagram(&buf, []byte("hello")))
+	require.NoError(t, WriteDatagram(&buf, nil))
+	assert.ErrorIs(t, WriteDatagram(&buf, make([]byte, MaxDatagramSize+1)), ErrDatagramTooLarge)
+
+	datagram := make([]byte, MaxDatagramSize)
+	n, err := ReadDatagram(&buf, datagram)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(datagram[:n]))
+	n, err = ReadDatagram(&buf, datagram)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+	_, err = ReadDatagram(&buf, datagram)
+	assert.ErrorIs(t, err, io.EOF)
+
+	_, err = ReadDatagram(bytes.NewReader([]byte{0, 5, 'h', 'e'}), datagram)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	_, err = ReadDatagram(bytes.NewReader([]byte{0, 5, 'h', 'e', 'l', 'l', 'o'}), make([]byte, 4))
+	assert.ErrorIs(t, err, ErrDatagramTooLarge)
+}
+
+// udpEchoServer echoes every datagram it receives back to its sender
+func udpEchoServer(t *testing.T) net.Addr {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	go func() {
+		buf := make([]byte, MaxDatagramSize)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			conn.WriteTo(buf[:n], addr)
+		}
+	}()
+	return conn.LocalAddr()
+}
+
+// udpForwardingTunnel sends the datagrams of each stream to address from a socket of its own, sending the
+// address of the socket on sockets
+func udpForwardingTunnel(session StreamingConnection, address string, sockets chan<- string) {
+	for {
+		stream, err := session.AcceptStream()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer stream.Close()
+			if _, err := ReadStreamHeader(stream, time.Second); err != nil {
+				return
+			}
+			conn, err := net.Dial("udp", address)
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			sockets <- conn.LocalAddr().String()
+			go func() {
+				buf := make([]byte, MaxDatagramSize)
+				for {
+					n, err := conn.Read(buf)
+					if err != nil || WriteDatagram(stream, buf[:n]) != nil {
+						return
+					}
+				}
+			}()
+			buf := make([]byte, MaxDatagramSize)
+			for {
+				n, err := ReadDatagram(stream, buf)
+				if err != nil {
+					return
+				}
+				conn.Write(buf[:n])
+			}
+		}()
+	}
+}
+
+func TestTcpTunnelForwardsUdpDatagrams(t *testing.T) {
+	packetConn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	freePort := packetConn.LocalAddr().(*net.UDPAddr).Port
+	packetConn.Close()
+
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	allocator := NewPortAllocator(zap.NewNop(), freePort, freePort, 0)
+	tunnel := NewTcpTunnel(zap.NewNop(), pool, TcpTunnelOptions{
+		PublicHost:             "yuka.dev:8081",
+		PortAllocator:          allocator,
+		PortReleaseGracePeriod: 50 * time.Millisecond,
+		UdpSessionIdleTimeout:  200 * time.Millisecond,
+	})
+
+	agentConn, serverConn := net.Pipe()
+	go tunnel.handleNewConnection(serverConn)
+	reply, err := ClientHandshake(agentConn, NewHello("dev", "", []TunnelRequest{{Protocol: TunnelProtocolUdp}}), time.Second)
+	require.NoError(t, err)
+	require.Len(t, reply.Tunnels, 1)
+	assert.Equal(t, freePort, reply.Tunnels[0].RemotePort)
+	assert.Equal(t, fmt.Sprintf("udp://yuka.dev:%d", freePort), reply.Tunnels[0].PublicURL)
+	assert.Equal(t, udpPoolKey(freePort), reply.Tunnels[0].Hostname)
+
+	agent := NewMuxSession(zap.NewNop(), agentConn, true, reply.Settings.MuxConfig())
+	sockets := make(chan string, 4)
+	go udpForwardingTunnel(agent, udpEchoServer(t).String(), sockets)
+	require.Eventually(t, func() bool { return len(pool.GetConnections(reply.Tunnels[0].Hostname)) == 1 }, time.Second, 10*time.Millisecond)
+
+	// Each peer gets a session of its own and only sees the replies to its own datagrams
+	var peers []net.Conn
+	for i := 0; i < 2; i++ {
+		peer, err := net.Dial("udp", fmt.Sprintf("127.0.0.1:%d", freePort))
+		require.NoError(t, err)
+		t.Cleanup(func() { peer.Close() })
+		peers = append(peers, peer)
+	}
+	buf := make([]byte, MaxDatagramSize)
+	for round := 0; round < 2; round++ {
+		for i, peer := range peers {
+			message := fmt.Sprintf("peer %d round %d", i, round)
+			_, err := peer.Write([]byte(message))
+			require.NoError(t, err)
+			require.NoError(t, peer.SetReadDeadline(time.Now().Add(time.Second)))
+			n, err := peer.Read(buf)
+			require.NoError(t, err)
+			assert.Equal(t, message, string(buf[:n]))
+		}
+	}
+	firstSocket, secondSocket := <-sockets, <-sockets
+	assert.NotEqual(t, firstSocket, secondSocket)
+	assert.Empty(t, sockets)
+
+	// Once a peer has been idle its session is closed and its next datagram opens a new one
+	tunnel.portListenersLock.Lock()
+	listener := tunnel.udpListeners[freePort]
+	tunnel.portListenersLock.Unlock()
+	require.Eventually(t, func() bool {
+		listener.sessionsLock.Lock()
+		defer listener.sessionsLock.Unlock()
+		return len(listener.sessions) == 0
+	}, 2*time.Second, 10*time.Millisecond)
+	_, err = peers[0].Write([]byte("again"))
+	require.NoError(t, err)
+	require.NoError(t, peers[0].SetReadDeadline(time.Now().Add(time.Second)))
+	n, err := peers[0].Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "again", string(buf[:n]))
+	assert.Len(t, sockets, 1)
+
+	// Once the agent goes away the port is released after the grace period
+	agent.Close()
+	require.Eventually(t, func() bool { return len(allocator.Reservations()) == 0 }, time.Second, 10*time.Millisecond)
+	packetConn, err = net.ListenPacket("udp", fmt.Sprintf(":%d", freePort))
+	require.NoError(t, err)
+	packetConn.Close()
+}
+
+// blockingConnection holds every stream being opened until unblock is closed
+type blockingConnection struct {
+	StreamingConnection
+	unblock chan struct{}
+}
+
+func (c *blockingConnection) OpenStream() (Stream, error) {
+	<-c.unblock
+	return c.StreamingConnection.OpenStream()
+}
+
+// listenUdpForTest listens for datagrams on a free port and registers an agent echoing them back as the
+// connection for it, its streams are opened through connection when it's given
+func listenUdpForTest(t *testing.T, maxSessions int, connection func(StreamingConnection) StreamingConnection) *udpPortListener {
+	serverConn, agentConn := net.Pipe()
+	server := NewMuxSession(zap.NewNop(), serverConn, false, nil)
+	agent := NewMuxSession(zap.NewNop(), agentConn, true, nil)
+	t.Cleanup(func() {
+		agent.Close()
+		server.Close()
+	})
+	go func() {
+		for {
+			stream, err := agent.AcceptStream()
+			if err != nil {
+				return
+			}
+			// Frames are echoed as they are so each datagram is sent back
+			go io.Copy(stream, stream)
+		}
+	}()
+
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	var registered StreamingConnection = server
+	if connection != nil {
+		registered = connection(server)
+	}
+	require.NoError(t, pool.AddConnection(udpPoolKey(0), "", registered))
+	listener, err := listenUdp(zap.NewNop().Sugar(), 0, pool, 200*time.Millisecond, maxSessions)
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	return listener
+}
+
+func (self *udpPortListener) numSessions() int {
+	self.sessionsLock.Lock()
+	defer self.sessionsLock.Unlock()
+	return len(self.sessions)
+}
+
+func TestUdpListenerCapsSessions(t *testing.T) {
+	listener := listenUdpForTest(t, 1, nil)
+	send := func(peer net.Conn, message string) error {
+		if _, err := peer.Write([]byte(message)); err != nil {
+			return err
+		}
+		require.NoError(t, peer.SetReadDeadline(time.Now().Add(100*time.Millisecond)))
+		buf := make([]byte, MaxDatagramSize)
+		n, err := peer.Read(buf)
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, message, string(buf[:n]))
+		return nil
+	}
+
+	var peers []net.Conn
+	for i := 0; i < 2; i++ {
+		peer, err := net.Dial("udp", listener.conn.LocalAddr().String())
+		require.NoError(t, err)
+		t.Cleanup(func() { peer.Close() })
+		peers = append(peers, peer)
+	}
+	require.NoError(t, send(peers[0], "first"))
+	// New peers are dropped while the listener is at its cap
+	assert.ErrorIs(t, send(peers[1], "second"), os.ErrDeadlineExceeded)
+	assert.Equal(t, 1, listener.numSessions())
+
+	// Once the first peer has gone idle there's room for the second
+	require.Eventually(t, func() bool { return listener.numSessions() == 0 }, 2*time.Second, 10*time.Millisecond)
+	require.NoError(t, send(peers[1], "second"))
+}
+
+func TestUdpListenerOpensStreamsOutsideLock(t *testing.T) {
+	unblock := make(chan struct{})
+	listener := listenUdpForTest(t, 0, func(server StreamingConnection) StreamingConnection {
+		return &blockingConnection{StreamingConnection: server, unblock: unblock}
+	})
+
+	var peers []net.Conn
+	for i := 0; i < 2; i++ {
+		peer, err := net.Dial("udp", listener.conn.LocalAddr().String())
+		require.NoError(t, err)
+		t.Cleanup(func() { peer.Close() })
+		_, err = peer.Write([]byte(fmt.Sprintf("peer %d", i)))
+		require.NoError(t, err)
+		peers = append(peers, peer)
+	}
+	// Both peers get a session while the stream of the first is still being opened
+	require.Eventually(t, func() bool { return listener.numSessions() == 2 }, time.Second, 10*time.Millisecond)
+
+	// The datagrams queued while the streams were opened are sent once they are
+	close(unblock)
+	buf := make([]byte, MaxDatagramSize)
+	for i, peer := range peers {
+		require.NoError(t, peer.SetReadDeadline(time.Now().Add(time.Second)))
+		n, err := peer.Read(buf)
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("peer %d", i), string(buf[:n]))
+	}
+}
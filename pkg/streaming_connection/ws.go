@@ -0,0 +1,129 @@
+package streaming_connection
+
+import (
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+/*
+ * Agents that can't reach the tunnel listener, i.e because they're behind an HTTP proxy that only allows
+ * web traffic, can connect over a websocket instead. The websocket carries exactly the same bytes as a TCP
+ * connection would, the handshake followed by the multiplexed frames, each write being sent as a binary
+ * message. Message boundaries mean nothing to either end.
+ **/
+
+// wsCloseTimeout is how long we wait to send the close message when closing a websocket
+const wsCloseTimeout = time.Second
+
+// WsConn is a net.Conn that reads and writes the binary messages of a websocket as a stream of bytes
+type WsConn struct {
+	ws *websocket.Conn
+	// reader is the message currently being read, nil once it has been read to the end
+	reader io.Reader
+
+	writeLock sync.Mutex
+	closeOnce sync.Once
+}
+
+func NewWsConn(ws *websocket.Conn) *WsConn {
+	return &WsConn{ws: ws}
+}
+
+func (self *WsConn) Read(b []byte) (int, error) {
+	for {
+		if self.reader == nil {
+			messageType, reader, err := self.ws.NextReader()
+			if err != nil {
+				if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+					return 0, io.EOF
+				}
+				return 0, err
+			}
+			if messageType != websocket.BinaryMessage {
+				continue
+			}
+			self.reader = reader
+		}
+
+		n, err := self.reader.Read(b)
+		if err == io.EOF {
+			self.reader = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+func (self *WsConn) Write(b []byte) (int, error) {
+	self.writeLock.Lock()
+	defer self.writeLock.Unlock()
+	if err := self.ws.WriteMessage(websocket.BinaryMessage, b); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+// Close tells the remote end the websocket is closing before closing the underlying connection
+func (self *WsConn) Close() error {
+	err := net.ErrClosed
+	self.closeOnce.Do(func() {
+		message := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+		self.ws.WriteControl(websocket.CloseMessage, message, time.Now().Add(wsCloseTimeout))
+		err = self.ws.Close()
+	})
+	return err
+}
+
+func (self *WsConn) LocalAddr() net.Addr {
+	return self.ws.LocalAddr()
+}
+
+func (self *WsConn) RemoteAddr() net.Addr {
+	return self.ws.RemoteAddr()
+}
+
+func (self *WsConn) SetDeadline(t time.Time) error {
+	if err := self.ws.SetReadDeadline(t); err != nil {
+		return err
+	}
+	return self.ws.SetWriteDeadline(t)
+}
+
+func (self *WsConn) SetReadDeadline(t time.Time) error {
+	return self.ws.SetReadDeadline(t)
+}
+
+func (self *WsConn) SetWriteDeadline(t time.Time) error {
+	return self.ws.SetWriteDeadline(t)
+}
+
+// WsStreamingConnection is a StreamingConnection for an agent that connected over a websocket
+type WsStreamingConnection struct {
+	*MuxSession
+	wsConn *WsConn
+	hello  *Hello
+}
+
+// NewWsStreamingConnection builds a WsStreamingConnection for an agent that has completed the handshake
+// over wsConn
+func NewWsStreamingConnection(logger *zap.Logger, wsConn *WsConn, hello *Hello, settings SessionSettings) *WsStreamingConnection {
+	return &WsStreamingConnection{
+		MuxSession: NewMuxSession(logger, wsConn, false, settings.MuxConfig()),
+		wsConn:     wsConn,
+		hello:      hello,
+	}
+}
+
+// ForTunnel returns the connection to add to the pool for the tunnel served on hostname, see
+// TcpStreamingConnection.ForTunnel
+func (self *WsStreamingConnection) ForTunnel(hostname string) StreamingConnection {
+	return forTunnel(self, self.hello, hostname)
+}
@@ -0,0 +1,82 @@
+package streaming_connection
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// dialWs starts a websocket server that serves each connection with serve and returns a connection to it
+func dialWs(t *testing.T, serve func(ws *websocket.Conn)) *WsConn {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		serve(ws)
+	}))
+	t.Cleanup(server.Close)
+
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	conn := NewWsConn(ws)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestWsConnReadsMessagesAsStream(t *testing.T) {
+	conn := dialWs(t, func(ws *websocket.Conn) {
+		ws.WriteMessage(websocket.BinaryMessage, []byte("hel"))
+		// Only binary messages carry data
+		ws.WriteMessage(websocket.TextMessage, []byte("ignored"))
+		ws.WriteMessage(websocket.BinaryMessage, []byte{})
+		ws.WriteMessage(websocket.BinaryMessage, []byte("lo"))
+		ws.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+		ws.Close()
+	})
+
+	b, err := io.ReadAll(conn)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(b))
+}
+
+func TestTcpTunnelServesWebSocketAgents(t *testing.T) {
+	pool := NewStreamingConnectionPool(zap.NewNop(), "yuka.dev")
+	tunnel := NewTcpTunnel(zap.NewNop(), pool, TcpTunnelOptions{PublicHost: "yuka.dev"})
+	conn := dialWs(t, func(ws *websocket.Conn) {
+		tunnel.ServeWebSocket(ws)
+	})
+
+	reply, err := ClientHandshake(conn, NewHello("dev", "", []TunnelRequest{{Protocol: TunnelProtocolHttp, Hostname: "foo"}}), time.Second)
+	require.NoError(t, err)
+	agent := NewMuxSession(zap.NewNop(), conn, true, reply.Settings.MuxConfig())
+	defer agent.Close()
+	hostnames := make(chan string, 1)
+	go echoTunnel(agent, hostnames)
+
+	require.Eventually(t, func() bool { return len(pool.GetConnections("foo")) == 1 }, time.Second, 10*time.Millisecond)
+	connection, _, err := pool.SelectConnectionForHost("foo.yuka.dev", "127.0.0.1")
+	require.NoError(t, err)
+	stream, err := connection.OpenStream()
+	require.NoError(t, err)
+	_, err = stream.Write([]byte("hello"))
+	require.NoError(t, err)
+	require.NoError(t, stream.CloseWrite())
+
+	b, err := io.ReadAll(stream)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(b))
+	assert.Equal(t, "foo", <-hostnames)
+
+	// The agent going away removes its tunnels from the pool
+	agent.Close()
+	require.Eventually(t, func() bool { return len(pool.GetConnections("foo")) == 0 }, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,508 @@
+package tls_helper
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/sync/singleflight"
+)
+
+/*
+ * AcmeManager obtains certificates from an ACME CA, i.e Let's Encrypt, the first time they're needed during a
+ * TLS handshake and renews them before they expire. When a DNSProvider is configured the base domain and its
+ * subdomains share one wildcard certificate, which can only be validated with a DNS-01 challenge. Every other
+ * domain, i.e a custom domain pointed at the tunnel router, gets its own certificate validated with HTTP-01.
+ *
+ * Certificates, the account key and the responses to pending HTTP-01 challenges are kept in a
+ * CertificateStore. Server instances sharing the store share the certificates, and whichever instance the
+ * CA's validation request lands on is able to answer the challenge.
+ **/
+
+const (
+	// defaultRenewBefore is how long before expiring certificates are renewed, Let's Encrypt's last 90 days
+	defaultRenewBefore = 30 * 24 * time.Hour
+	renewCheckInterval = 12 * time.Hour
+	// obtainTimeout bounds how long a handshake waits for a certificate to be obtained
+	obtainTimeout = 2 * time.Minute
+
+	acmeChallengePath  = "/.well-known/acme-challenge/"
+	dnsChallengePrefix = "_acme-challenge."
+)
+
+// ErrNotInStore is returned by a CertificateStore for anything that hasn't been stored
+var ErrNotInStore = errors.New("not in certificate store")
+
+// StoredCertificate is a certificate chain and its private key, PEM encoded
+type StoredCertificate struct {
+	// Name is what the certificate is stored under, the domain it's for or "*.<domain>" for a wildcard
+	Name           string
+	CertificatePEM []byte
+	PrivateKeyPEM  []byte
+	ExpiresAt      time.Time
+}
+
+// TLSCertificate parses the certificate for serving
+func (self *StoredCertificate) TLSCertificate() (*tls.Certificate, error) {
+	certificate, err := tls.X509KeyPair(self.CertificatePEM, self.PrivateKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+	if certificate.Leaf == nil {
+		if certificate.Leaf, err = x509.ParseCertificate(certificate.Certificate[0]); err != nil {
+			return nil, err
+		}
+	}
+	return &certificate, nil
+}
+
+// CertificateStore persists what an AcmeManager shares between server instances. Every Get returns
+// ErrNotInStore when there's nothing stored.
+type CertificateStore interface {
+	GetCertificate(ctx context.Context, name string) (*StoredCertificate, error)
+	// PutCertificate stores certificate, replacing any stored under the same name
+	PutCertificate(ctx context.Context, certificate *StoredCertificate) error
+	// GetAccountKey returns the PEM encoded key of the account registered with the CA at directoryURL
+	GetAccountKey(ctx context.Context, directoryURL string) ([]byte, error)
+	PutAccountKey(ctx context.Context, directoryURL string, keyPEM []byte) error
+	// GetChallenge returns the key authorization served for the HTTP-01 challenge with token
+	GetChallenge(ctx context.Context, token string) (string, error)
+	PutChallenge(ctx context.Context, token string, keyAuthorization string) error
+	DeleteChallenge(ctx context.Context, token string) error
+}
+
+// DNSProvider creates the TXT records that answer DNS-01 challenges. Present should only return once the
+// record can be resolved.
+type DNSProvider interface {
+	Present(ctx context.Context, fqdn string, value string) error
+	CleanUp(ctx context.Context, fqdn string, value string) error
+}
+
+// AcmeOptions configures an AcmeManager
+type AcmeOptions struct {
+	// DirectoryURL is the directory of the ACME CA, Let's Encrypt's when empty
+	DirectoryURL string
+	// Email is optional, the CA uses it to warn about certificates that are about to expire
+	Email string
+	Store CertificateStore
+	// BaseDomain is the domain tunnels are served from subdomains of, i.e yuka.dev
+	BaseDomain string
+	// DNSProvider answers DNS-01 challenges, when set the base domain and its subdomains share a wildcard
+	// certificate. Without it every domain gets its own certificate validated with HTTP-01.
+	DNSProvider DNSProvider
+	// HostPolicy decides whether a certificate may be obtained for a domain the wildcard certificate doesn't
+	// cover, every domain is allowed when it's nil
+	HostPolicy func(ctx context.Context, host string) error
+	// RenewBefore is how long before expiring certificates are renewed, 30 days when zero
+	RenewBefore time.Duration
+	// HTTPClient talks to the CA, it only needs setting for a CA whose certificate isn't trusted by the
+	// system, i.e a local pebble
+	HTTPClient *http.Client
+}
+
+type AcmeManager struct {
+	slogger zap.SugaredLogger
+	options AcmeOptions
+
+	clientLock sync.Mutex
+	client     *acme.Client
+
+	// certificates are those served so far by name
+	certificatesLock sync.RWMutex
+	certificates     map[string]*tls.Certificate
+	// obtaining makes concurrent handshakes for a name wait for the same certificate
+	obtaining singleflight.Group
+}
+
+func NewAcmeManager(logger *zap.Logger, options AcmeOptions) *AcmeManager {
+	if options.DirectoryURL == "" {
+		options.DirectoryURL = acme.LetsEncryptURL
+	}
+	if options.RenewBefore == 0 {
+		options.RenewBefore = defaultRenewBefore
+	}
+	options.BaseDomain = strings.ToLower(options.BaseDomain)
+	return &AcmeManager{
+		slogger:      *logger.Sugar(),
+		options:      options,
+		certificates: make(map[string]*tls.Certificate),
+	}
+}
+
+// TLSConfig returns the config for a listener serving the manager's certificates
+func (self *AcmeManager) TLSConfig() *tls.Config {
+	return &tls.Config{
+		GetCertificate: self.GetCertificate,
+		MinVersion:     tls.VersionTLS12,
+	}
+}
+
+// GetCertificate implements tls.Config's GetCertificate, returning the certificate for the server name the
+// client asked for. The handshake waits while a certificate that isn't in the store yet is obtained.
+func (self *AcmeManager) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	serverName := strings.ToLower(strings.TrimSuffix(hello.ServerName, "."))
+	if serverName == "" {
+		return nil, errors.New("missing server name")
+	}
+	name, domains := self.certificateName(serverName)
+	if certificate := self.cachedCertificate(name); certificate != nil {
+		return certificate, nil
+	}
+	if !isWildcard(name) && self.options.HostPolicy != nil {
+		if err := self.options.HostPolicy(hello.Context(), serverName); err != nil {
+			return nil, err
+		}
+	}
+
+	certificate, err, _ := self.obtaining.Do(name, func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), obtainTimeout)
+		defer cancel()
+		certificate, err := self.loadCertificate(ctx, name)
+		if err == nil {
+			return certificate, nil
+		}
+		if !errors.Is(err, ErrNotInStore) {
+			return nil, err
+		}
+		return self.obtain(ctx, name, domains)
+	})
+	if err != nil {
+		self.slogger.Errorf("Error getting certificate for %s: %v", serverName, err)
+		return nil, err
+	}
+	return certificate.(*tls.Certificate), nil
+}
+
+// HTTPHandler answers the HTTP-01 challenges of the CA, every other request is passed on to fallback
+func (self *AcmeManager) HTTPHandler(fallback http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.URL.Path, acmeChallengePath)
+		if !ok {
+			fallback.ServeHTTP(w, r)
+			return
+		}
+		keyAuthorization, err := self.options.Store.GetChallenge(r.Context(), token)
+		if err != nil {
+			if !errors.Is(err, ErrNotInStore) {
+				self.slogger.Errorf("Error getting ACME challenge %s: %v", token, err)
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+			fallback.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte(keyAuthorization))
+	})
+}
+
+// Run is a blocking call that renews certificates before they expire
+//
+// Will close on ctx.Done() being called
+func (self *AcmeManager) Run(ctx context.Context) error {
+	ticker := time.NewTicker(renewCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			self.RenewCertificates(ctx)
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// RenewCertificates renews the certificates served so far that expire within RenewBefore. Another server
+// instance may have renewed a certificate already, in which case the one it stored is used.
+func (self *AcmeManager) RenewCertificates(ctx context.Context) {
+	self.certificatesLock.RLock()
+	certificates := make(map[string]*tls.Certificate, len(self.certificates))
+	for name, certificate := range self.certificates {
+		certificates[name] = certificate
+	}
+	self.certificatesLock.RUnlock()
+
+	for name, certificate := range certificates {
+		if !self.needsRenewal(certificate) {
+			continue
+		}
+		if stored, err := self.loadCertificate(ctx, name); err == nil && !self.needsRenewal(stored) {
+			continue
+		}
+		if _, err := self.obtain(ctx, name, certificate.Leaf.DNSNames); err != nil {
+			self.slogger.Errorf("Error renewing certificate for %s: %v", name, err)
+		}
+	}
+}
+
+// certificateName returns the name the certificate for serverName is stored under and the domains it's for
+func (self *AcmeManager) certificateName(serverName string) (string, []string) {
+	baseDomain := self.options.BaseDomain
+	if self.options.DNSProvider != nil && baseDomain != "" {
+		subdomain, ok := strings.CutSuffix(serverName, "."+baseDomain)
+		if serverName == baseDomain || ok && !strings.Contains(subdomain, ".") {
+			return "*." + baseDomain, []string{"*." + baseDomain, baseDomain}
+		}
+	}
+	return serverName, []string{serverName}
+}
+
+// cachedCertificate returns the certificate served for name so far, nil when there isn't one that's valid
+func (self *AcmeManager) cachedCertificate(name string) *tls.Certificate {
+	self.certificatesLock.RLock()
+	defer self.certificatesLock.RUnlock()
+	certificate, ok := self.certificates[name]
+	if !ok || time.Now().After(certificate.Leaf.NotAfter) {
+		return nil
+	}
+	return certificate
+}
+
+func (self *AcmeManager) cacheCertificate(name string, certificate *tls.Certificate) {
+	self.certificatesLock.Lock()
+	defer self.certificatesLock.Unlock()
+	self.certificates[name] = certificate
+}
+
+// loadCertificate returns the certificate stored under name, ErrNotInStore when it has expired
+func (self *AcmeManager) loadCertificate(ctx context.Context, name string) (*tls.Certificate, error) {
+	stored, err := self.options.Store.GetCertificate(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	certificate, err := stored.TLSCertificate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate stored for %s: %v", name, err)
+	}
+	if time.Now().After(certificate.Leaf.NotAfter) {
+		return nil, ErrNotInStore
+	}
+	self.cacheCertificate(name, certificate)
+	return certificate, nil
+}
+
+func (self *AcmeManager) needsRenewal(certificate *tls.Certificate) bool {
+	return time.Now().Add(self.options.RenewBefore).After(certificate.Leaf.NotAfter)
+}
+
+// obtain orders a certificate for domains from the CA, answering a challenge for each of them, and stores it
+// under name
+func (self *AcmeManager) obtain(ctx context.Context, name string, domains []string) (*tls.Certificate, error) {
+	self.slogger.Infof("Obtaining a certificate for %s", strings.Join(domains, ", "))
+	client, err := self.acmeClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(domains...))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create order: %v", err)
+	}
+	for _, url := range order.AuthzURLs {
+		if err := self.authorize(ctx, client, url); err != nil {
+			return nil, err
+		}
+	}
+	if order, err = client.WaitOrder(ctx, order.URI); err != nil {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: domains[0]},
+		DNSNames: domains,
+	}, key)
+	if err != nil {
+		return nil, err
+	}
+	chain, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, fmt.Errorf("unable to finalize order: %v", err)
+	}
+
+	stored, err := newStoredCertificate(name, chain, key)
+	if err != nil {
+		return nil, err
+	}
+	certificate, err := stored.TLSCertificate()
+	if err != nil {
+		return nil, err
+	}
+	if err := self.options.Store.PutCertificate(ctx, stored); err != nil {
+		return nil, fmt.Errorf("unable to store certificate: %v", err)
+	}
+	self.cacheCertificate(name, certificate)
+	self.slogger.Infof("Obtained a certificate for %s expiring at %v", strings.Join(domains, ", "), stored.ExpiresAt)
+	return certificate, nil
+}
+
+// authorize proves control of the domain of the authorization at url. The wildcard certificate's domains are
+// validated over DNS, every other domain over HTTP.
+func (self *AcmeManager) authorize(ctx context.Context, client *acme.Client, url string) error {
+	authorization, err := client.GetAuthorization(ctx, url)
+	if err != nil {
+		return err
+	}
+	if authorization.Status == acme.StatusValid {
+		return nil
+	}
+	domain := authorization.Identifier.Value
+	challengeType := "http-01"
+	if self.options.DNSProvider != nil && (authorization.Wildcard || domain == self.options.BaseDomain) {
+		challengeType = "dns-01"
+	}
+	var challenge *acme.Challenge
+	for _, c := range authorization.Challenges {
+		if c.Type == challengeType {
+			challenge = c
+		}
+	}
+	if challenge == nil {
+		return fmt.Errorf("no %s challenge offered for %s", challengeType, domain)
+	}
+
+	switch challengeType {
+	case "dns-01":
+		value, err := client.DNS01ChallengeRecord(challenge.Token)
+		if err != nil {
+			return err
+		}
+		fqdn := dnsChallengePrefix + domain
+		if err := self.options.DNSProvider.Present(ctx, fqdn, value); err != nil {
+			return fmt.Errorf("unable to create DNS record %s: %v", fqdn, err)
+		}
+		defer func() {
+			if err := self.options.DNSProvider.CleanUp(context.Background(), fqdn, value); err != nil {
+				self.slogger.Warnf("Error deleting DNS record %s: %v", fqdn, err)
+			}
+		}()
+	default:
+		keyAuthorization, err := client.HTTP01ChallengeResponse(challenge.Token)
+		if err != nil {
+			return err
+		}
+		if err := self.options.Store.PutChallenge(ctx, challenge.Token, keyAuthorization); err != nil {
+			return fmt.Errorf("unable to store challenge: %v", err)
+		}
+		defer func() {
+			if err := self.options.Store.DeleteChallenge(context.Background(), challenge.Token); err != nil {
+				self.slogger.Warnf("Error deleting ACME challenge %s: %v", challenge.Token, err)
+			}
+		}()
+	}
+
+	if _, err := client.Accept(ctx, challenge); err != nil {
+		return fmt.Errorf("unable to accept %s challenge for %s: %v", challengeType, domain, err)
+	}
+	if _, err := client.WaitAuthorization(ctx, authorization.URI); err != nil {
+		return fmt.Errorf("unable to validate %s: %v", domain, err)
+	}
+	return nil
+}
+
+// acmeClient returns the client for the CA, registering the account the first time it's called. The
+// account's key is stored so every server instance uses the same account.
+func (self *AcmeManager) acmeClient(ctx context.Context) (*acme.Client, error) {
+	self.clientLock.Lock()
+	defer self.clientLock.Unlock()
+	if self.client != nil {
+		return self.client, nil
+	}
+
+	key, err := self.accountKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := &acme.Client{
+		Key:          key,
+		HTTPClient:   self.options.HTTPClient,
+		DirectoryURL: self.options.DirectoryURL,
+		UserAgent:    "yuka",
+	}
+	account := &acme.Account{}
+	if self.options.Email != "" {
+		account.Contact = []string{"mailto:" + self.options.Email}
+	}
+	if _, err := client.Register(ctx, account, acme.AcceptTOS); err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return nil, fmt.Errorf("unable to register ACME account: %v", err)
+	}
+	self.client = client
+	return client, nil
+}
+
+func (self *AcmeManager) accountKey(ctx context.Context) (*ecdsa.PrivateKey, error) {
+	keyPEM, err := self.options.Store.GetAccountKey(ctx, self.options.DirectoryURL)
+	if err == nil {
+		block, _ := pem.Decode(keyPEM)
+		if block == nil {
+			return nil, errors.New("invalid ACME account key")
+		}
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+	if !errors.Is(err, ErrNotInStore) {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	if keyPEM, err = encodePrivateKey(key); err != nil {
+		return nil, err
+	}
+	if err := self.options.Store.PutAccountKey(ctx, self.options.DirectoryURL, keyPEM); err != nil {
+		return nil, fmt.Errorf("unable to store ACME account key: %v", err)
+	}
+	return key, nil
+}
+
+func newStoredCertificate(name string, chain [][]byte, key *ecdsa.PrivateKey) (*StoredCertificate, error) {
+	if len(chain) == 0 {
+		return nil, errors.New("empty certificate chain")
+	}
+	leaf, err := x509.ParseCertificate(chain[0])
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := encodePrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	var certificatePEM []byte
+	for _, der := range chain {
+		certificatePEM = append(certificatePEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+	return &StoredCertificate{
+		Name:           name,
+		CertificatePEM: certificatePEM,
+		PrivateKeyPEM:  keyPEM,
+		ExpiresAt:      leaf.NotAfter,
+	}, nil
+}
+
+func encodePrivateKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+}
+
+func isWildcard(name string) bool {
+	return strings.HasPrefix(name, "*.")
+}
@@ -0,0 +1,556 @@
+package tls_helper
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// fakeAcmeServer is a minimal ACME CA. Request signatures aren't checked but challenges are validated: the
+// HTTP-01 response is fetched from httpAddr whatever the domain and DNS-01 records are looked up with
+// dnsRecords.
+type fakeAcmeServer struct {
+	*httptest.Server
+	ca         *CA
+	httpAddr   string
+	dnsRecords func(fqdn string) []string
+
+	lock sync.Mutex
+	// validity of the certificates issued
+	validity       time.Duration
+	thumbprint     string
+	orders         []*fakeOrder
+	authorizations []*fakeAuthorization
+}
+
+type fakeOrder struct {
+	identifiers    []string
+	authorizations []int
+	certificate    []byte
+}
+
+type fakeAuthorization struct {
+	domain   string
+	wildcard bool
+	token    string
+	status   string
+}
+
+func newFakeAcmeServer(t *testing.T) *fakeAcmeServer {
+	ca, err := NewCA("fake ACME CA")
+	require.NoError(t, err)
+	server := &fakeAcmeServer{ca: ca, validity: 90 * 24 * time.Hour}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /directory", server.directory)
+	mux.HandleFunc("HEAD /nonce", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("POST /account", server.newAccount)
+	mux.HandleFunc("POST /order", server.newOrder)
+	mux.HandleFunc("POST /order/{id}", server.getOrder)
+	mux.HandleFunc("POST /authz/{id}", server.getAuthorization)
+	mux.HandleFunc("POST /challenge/{id}/{type}", server.acceptChallenge)
+	mux.HandleFunc("POST /finalize/{id}", server.finalize)
+	mux.HandleFunc("POST /certificate/{id}", server.getCertificate)
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Replay-Nonce", randomToken())
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (self *fakeAcmeServer) numOrders() int {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return len(self.orders)
+}
+
+func (self *fakeAcmeServer) directory(w http.ResponseWriter, r *http.Request) {
+	writeJson(w, http.StatusOK, map[string]string{
+		"newNonce":   self.URL + "/nonce",
+		"newAccount": self.URL + "/account",
+		"newOrder":   self.URL + "/order",
+		"revokeCert": self.URL + "/revoke",
+		"keyChange":  self.URL + "/key-change",
+	})
+}
+
+func (self *fakeAcmeServer) newAccount(w http.ResponseWriter, r *http.Request) {
+	var header struct {
+		JWK struct{ Crv, Kty, X, Y string }
+	}
+	readJws(r, &header, nil)
+	jwk := fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q,"y":%q}`, header.JWK.Crv, header.JWK.Kty, header.JWK.X, header.JWK.Y)
+	sum := sha256.Sum256([]byte(jwk))
+
+	self.lock.Lock()
+	self.thumbprint = base64.RawURLEncoding.EncodeToString(sum[:])
+	self.lock.Unlock()
+	w.Header().Set("Location", self.URL+"/account/1")
+	writeJson(w, http.StatusCreated, map[string]string{"status": "valid"})
+}
+
+func (self *fakeAcmeServer) newOrder(w http.ResponseWriter, r *http.Request) {
+	var payload struct {
+		Identifiers []struct{ Value string }
+	}
+	readJws(r, nil, &payload)
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	order := &fakeOrder{}
+	for _, identifier := range payload.Identifiers {
+		domain, wildcard := strings.CutPrefix(identifier.Value, "*.")
+		order.identifiers = append(order.identifiers, identifier.Value)
+		order.authorizations = append(order.authorizations, len(self.authorizations))
+		self.authorizations = append(self.authorizations, &fakeAuthorization{
+			domain:   domain,
+			wildcard: wildcard,
+			token:    randomToken(),
+			status:   "pending",
+		})
+	}
+	self.orders = append(self.orders, order)
+	id := len(self.orders) - 1
+	w.Header().Set("Location", fmt.Sprintf("%s/order/%d", self.URL, id))
+	writeJson(w, http.StatusCreated, self.orderJson(id))
+}
+
+func (self *fakeAcmeServer) getOrder(w http.ResponseWriter, r *http.Request) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	writeJson(w, http.StatusOK, self.orderJson(pathId(r)))
+}
+
+func (self *fakeAcmeServer) getAuthorization(w http.ResponseWriter, r *http.Request) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	id := pathId(r)
+	authorization := self.authorizations[id]
+	var challenges []any
+	for _, challengeType := range []string{"http-01", "dns-01"} {
+		challenges = append(challenges, map[string]string{
+			"type":   challengeType,
+			"url":    fmt.Sprintf("%s/challenge/%d/%s", self.URL, id, challengeType),
+			"token":  authorization.token,
+			"status": authorization.status,
+		})
+	}
+	writeJson(w, http.StatusOK, map[string]any{
+		"status":     authorization.status,
+		"identifier": map[string]string{"type": "dns", "value": authorization.domain},
+		"wildcard":   authorization.wildcard,
+		"challenges": challenges,
+	})
+}
+
+// acceptChallenge validates the challenge straight away rather than leaving the client to poll for it
+func (self *fakeAcmeServer) acceptChallenge(w http.ResponseWriter, r *http.Request) {
+	self.lock.Lock()
+	authorization := self.authorizations[pathId(r)]
+	keyAuthorization := authorization.token + "." + self.thumbprint
+	self.lock.Unlock()
+
+	valid := false
+	switch r.PathValue("type") {
+	case "http-01":
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://%s/.well-known/acme-challenge/%s", self.httpAddr, authorization.token), nil)
+		if err == nil && !authorization.wildcard {
+			req.Host = authorization.domain
+			if resp, err := http.DefaultClient.Do(req); err == nil {
+				b, _ := io.ReadAll(resp.Body)
+				resp.Body.Close()
+				valid = resp.StatusCode == http.StatusOK && string(b) == keyAuthorization
+			}
+		}
+	case "dns-01":
+		sum := sha256.Sum256([]byte(keyAuthorization))
+		valid = self.dnsRecords != nil && slices.Contains(self.dnsRecords("_acme-challenge."+authorization.domain), base64.RawURLEncoding.EncodeToString(sum[:]))
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	authorization.status = "invalid"
+	if valid {
+		authorization.status = "valid"
+	}
+	writeJson(w, http.StatusOK, map[string]string{
+		"type":   r.PathValue("type"),
+		"url":    self.URL + r.URL.Path,
+		"token":  authorization.token,
+		"status": authorization.status,
+	})
+}
+
+func (self *fakeAcmeServer) finalize(w http.ResponseWriter, r *http.Request) {
+	var payload struct{ CSR string }
+	readJws(r, nil, &payload)
+	der, _ := base64.RawURLEncoding.DecodeString(payload.CSR)
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		writeProblem(w, "badCSR", err.Error())
+		return
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	id := pathId(r)
+	order := self.orders[id]
+	if status := self.orderStatus(order); status != "ready" {
+		writeProblem(w, "orderNotReady", "order is "+status)
+		return
+	}
+	if !slices.Equal(slices.Sorted(slices.Values(csr.DNSNames)), slices.Sorted(slices.Values(order.identifiers))) {
+		writeProblem(w, "badCSR", "CSR doesn't match the order")
+		return
+	}
+	template, err := certificateTemplate(csr.DNSNames[0], self.validity)
+	if err != nil {
+		writeProblem(w, "serverInternal", err.Error())
+		return
+	}
+	template.NotBefore = time.Now()
+	template.DNSNames = csr.DNSNames
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, self.ca.Certificate, csr.PublicKey, self.ca.key)
+	if err != nil {
+		writeProblem(w, "serverInternal", err.Error())
+		return
+	}
+	order.certificate = append(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate}), self.ca.CertificatePEM()...)
+	w.Header().Set("Location", fmt.Sprintf("%s/order/%d", self.URL, id))
+	writeJson(w, http.StatusOK, self.orderJson(id))
+}
+
+func (self *fakeAcmeServer) getCertificate(w http.ResponseWriter, r *http.Request) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	w.Header().Set("Content-Type", "application/pem-certificate-chain")
+	w.Write(self.orders[pathId(r)].certificate)
+}
+
+func (self *fakeAcmeServer) orderStatus(order *fakeOrder) string {
+	if order.certificate != nil {
+		return "valid"
+	}
+	status := "ready"
+	for _, id := range order.authorizations {
+		switch self.authorizations[id].status {
+		case "invalid":
+			return "invalid"
+		case "pending":
+			status = "pending"
+		}
+	}
+	return status
+}
+
+func (self *fakeAcmeServer) orderJson(id int) map[string]any {
+	order := self.orders[id]
+	var identifiers []any
+	for _, identifier := range order.identifiers {
+		identifiers = append(identifiers, map[string]string{"type": "dns", "value": identifier})
+	}
+	var authorizations []string
+	for _, authorization := range order.authorizations {
+		authorizations = append(authorizations, fmt.Sprintf("%s/authz/%d", self.URL, authorization))
+	}
+	response := map[string]any{
+		"status":         self.orderStatus(order),
+		"identifiers":    identifiers,
+		"authorizations": authorizations,
+		"finalize":       fmt.Sprintf("%s/finalize/%d", self.URL, id),
+	}
+	if order.certificate != nil {
+		response["certificate"] = fmt.Sprintf("%s/certificate/%d", self.URL, id)
+	}
+	return response
+}
+
+func randomToken() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// readJws decodes the protected header and payload of the JWS in the body of r
+func readJws(r *http.Request, header any, payload any) {
+	var jws struct{ Protected, Payload string }
+	json.NewDecoder(r.Body).Decode(&jws)
+	if header != nil {
+		b, _ := base64.RawURLEncoding.DecodeString(jws.Protected)
+		json.Unmarshal(b, header)
+	}
+	if payload != nil {
+		b, _ := base64.RawURLEncoding.DecodeString(jws.Payload)
+		json.Unmarshal(b, payload)
+	}
+}
+
+func pathId(r *http.Request) int {
+	id, _ := strconv.Atoi(r.PathValue("id"))
+	return id
+}
+
+func writeJson(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeProblem(w http.ResponseWriter, problem string, detail string) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(map[string]string{"type": "urn:ietf:params:acme:error:" + problem, "detail": detail})
+}
+
+type memoryCertificateStore struct {
+	lock         sync.Mutex
+	certificates map[string]*StoredCertificate
+	accountKeys  map[string][]byte
+	challenges   map[string]string
+}
+
+func newMemoryCertificateStore() *memoryCertificateStore {
+	return &memoryCertificateStore{
+		certificates: make(map[string]*StoredCertificate),
+		accountKeys:  make(map[string][]byte),
+		challenges:   make(map[string]string),
+	}
+}
+
+func (self *memoryCertificateStore) GetCertificate(ctx context.Context, name string) (*StoredCertificate, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	if certificate, ok := self.certificates[name]; ok {
+		return certificate, nil
+	}
+	return nil, ErrNotInStore
+}
+
+func (self *memoryCertificateStore) PutCertificate(ctx context.Context, certificate *StoredCertificate) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.certificates[certificate.Name] = certificate
+	return nil
+}
+
+func (self *memoryCertificateStore) GetAccountKey(ctx context.Context, directoryURL string) ([]byte, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	if key, ok := self.accountKeys[directoryURL]; ok {
+		return key, nil
+	}
+	return nil, ErrNotInStore
+}
+
+func (self *memoryCertificateStore) PutAccountKey(ctx context.Context, directoryURL string, keyPEM []byte) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.accountKeys[directoryURL] = keyPEM
+	return nil
+}
+
+func (self *memoryCertificateStore) GetChallenge(ctx context.Context, token string) (string, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	if keyAuthorization, ok := self.challenges[token]; ok {
+		return keyAuthorization, nil
+	}
+	return "", ErrNotInStore
+}
+
+func (self *memoryCertificateStore) PutChallenge(ctx context.Context, token string, keyAuthorization string) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.challenges[token] = keyAuthorization
+	return nil
+}
+
+func (self *memoryCertificateStore) DeleteChallenge(ctx context.Context, token string) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	delete(self.challenges, token)
+	return nil
+}
+
+type memoryDNSProvider struct {
+	lock    sync.Mutex
+	records map[string][]string
+}
+
+func (self *memoryDNSProvider) Present(ctx context.Context, fqdn string, value string) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.records[fqdn] = append(self.records[fqdn], value)
+	return nil
+}
+
+func (self *memoryDNSProvider) CleanUp(ctx context.Context, fqdn string, value string) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.records[fqdn] = slices.DeleteFunc(self.records[fqdn], func(v string) bool { return v == value })
+	return nil
+}
+
+func (self *memoryDNSProvider) lookup(fqdn string) []string {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return slices.Clone(self.records[fqdn])
+}
+
+// newAcmeManager returns a manager obtaining certificates from acmeServer, which validates HTTP-01
+// challenges against the manager's HTTP handler
+func newAcmeManager(t *testing.T, acmeServer *fakeAcmeServer, options AcmeOptions) *AcmeManager {
+	options.DirectoryURL = acmeServer.URL + "/directory"
+	manager := NewAcmeManager(zap.NewNop(), options)
+	if acmeServer.httpAddr == "" {
+		httpServer := httptest.NewServer(manager.HTTPHandler(http.NotFoundHandler()))
+		t.Cleanup(httpServer.Close)
+		acmeServer.httpAddr = httpServer.Listener.Addr().String()
+	}
+	return manager
+}
+
+func getCertificate(manager *AcmeManager, serverName string) (*tls.Certificate, error) {
+	return manager.GetCertificate(&tls.ClientHelloInfo{ServerName: serverName})
+}
+
+func allowHosts(hosts ...string) func(context.Context, string) error {
+	return func(ctx context.Context, host string) error {
+		if !slices.Contains(hosts, host) {
+			return errors.New("unknown host")
+		}
+		return nil
+	}
+}
+
+func TestAcmeManagerObtainsCertificatesOverHttp01(t *testing.T) {
+	acmeServer := newFakeAcmeServer(t)
+	store := newMemoryCertificateStore()
+	manager := newAcmeManager(t, acmeServer, AcmeOptions{
+		Store:      store,
+		BaseDomain: "yuka.test",
+		HostPolicy: allowHosts("app.example.com", "foo.yuka.test"),
+	})
+	address := serveTls(t, manager.TLSConfig())
+
+	conn, err := tls.Dial("tcp", address, &tls.Config{ServerName: "app.example.com", RootCAs: acmeServer.ca.CertPool()})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"app.example.com"}, conn.ConnectionState().PeerCertificates[0].DNSNames)
+	conn.Close()
+
+	// Without a DNS provider subdomains of the base domain get their own certificate too
+	certificate, err := getCertificate(manager, "foo.yuka.test")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"foo.yuka.test"}, certificate.Leaf.DNSNames)
+	assert.Equal(t, 2, acmeServer.numOrders())
+
+	_, err = tls.Dial("tcp", address, &tls.Config{ServerName: "blocked.example.com", RootCAs: acmeServer.ca.CertPool()})
+	assert.Error(t, err)
+	_, err = getCertificate(manager, "blocked.example.com")
+	assert.EqualError(t, err, "unknown host")
+	assert.Equal(t, 2, acmeServer.numOrders())
+	assert.Empty(t, store.challenges)
+
+	// Another server instance sharing the store serves the same certificate without obtaining it again
+	other := newAcmeManager(t, acmeServer, AcmeOptions{Store: store, HostPolicy: allowHosts("app.example.com")})
+	stored, err := getCertificate(other, "app.example.com")
+	require.NoError(t, err)
+	served, err := getCertificate(manager, "app.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, served.Leaf.SerialNumber, stored.Leaf.SerialNumber)
+	assert.Equal(t, 2, acmeServer.numOrders())
+}
+
+func TestAcmeManagerObtainsWildcardCertificatesOverDns01(t *testing.T) {
+	dnsProvider := &memoryDNSProvider{records: make(map[string][]string)}
+	acmeServer := newFakeAcmeServer(t)
+	acmeServer.dnsRecords = dnsProvider.lookup
+	manager := newAcmeManager(t, acmeServer, AcmeOptions{
+		Store:       newMemoryCertificateStore(),
+		BaseDomain:  "yuka.test",
+		DNSProvider: dnsProvider,
+		// The wildcard certificate isn't subject to the host policy
+		HostPolicy: allowHosts(),
+	})
+
+	// Handshakes waiting on the same certificate share the one order
+	var wg sync.WaitGroup
+	certificates := make([]*tls.Certificate, 10)
+	for i := range certificates {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			certificate, err := getCertificate(manager, fmt.Sprintf("app%d.yuka.test", i))
+			assert.NoError(t, err)
+			certificates[i] = certificate
+		}()
+	}
+	wg.Wait()
+	require.NotNil(t, certificates[0])
+	assert.Equal(t, []string{"*.yuka.test", "yuka.test"}, certificates[0].Leaf.DNSNames)
+	for _, certificate := range certificates {
+		assert.Same(t, certificates[0], certificate)
+	}
+
+	certificate, err := getCertificate(manager, "yuka.test")
+	require.NoError(t, err)
+	assert.Same(t, certificates[0], certificate)
+	assert.Equal(t, 1, acmeServer.numOrders())
+	assert.Empty(t, dnsProvider.lookup("_acme-challenge.yuka.test"))
+
+	// Only a single level of subdomain is covered by the wildcard
+	_, err = getCertificate(manager, "a.b.yuka.test")
+	assert.EqualError(t, err, "unknown host")
+}
+
+func TestAcmeManagerRenewsCertificates(t *testing.T) {
+	acmeServer := newFakeAcmeServer(t)
+	acmeServer.validity = 10 * 24 * time.Hour
+	store := newMemoryCertificateStore()
+	manager := newAcmeManager(t, acmeServer, AcmeOptions{Store: store})
+	other := newAcmeManager(t, acmeServer, AcmeOptions{Store: store})
+
+	expiring, err := getCertificate(manager, "app.example.com")
+	require.NoError(t, err)
+	_, err = getCertificate(other, "app.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, 1, acmeServer.numOrders())
+
+	acmeServer.lock.Lock()
+	acmeServer.validity = 90 * 24 * time.Hour
+	acmeServer.lock.Unlock()
+	manager.RenewCertificates(context.Background())
+	assert.Equal(t, 2, acmeServer.numOrders())
+	renewed, err := getCertificate(manager, "app.example.com")
+	require.NoError(t, err)
+	assert.NotEqual(t, expiring.Leaf.SerialNumber, renewed.Leaf.SerialNumber)
+
+	// The other instance picks up the renewed certificate from the store rather than renewing it again
+	other.RenewCertificates(context.Background())
+	assert.Equal(t, 2, acmeServer.numOrders())
+	served, err := getCertificate(other, "app.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, renewed.Leaf.SerialNumber, served.Leaf.SerialNumber)
+}
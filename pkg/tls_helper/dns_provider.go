@@ -0,0 +1,35 @@
+package tls_helper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+)
+
+// ExecDNSProvider creates DNS-01 records by running a command, so any DNS host can be used with a small
+// script. It's run as "<command> present <fqdn> <value>" and "<command> cleanup <fqdn> <value>" and should
+// only exit once the record has been created or deleted.
+type ExecDNSProvider struct {
+	command string
+}
+
+func NewExecDNSProvider(command string) *ExecDNSProvider {
+	return &ExecDNSProvider{command: command}
+}
+
+func (self *ExecDNSProvider) Present(ctx context.Context, fqdn string, value string) error {
+	return self.run(ctx, "present", fqdn, value)
+}
+
+func (self *ExecDNSProvider) CleanUp(ctx context.Context, fqdn string, value string) error {
+	return self.run(ctx, "cleanup", fqdn, value)
+}
+
+func (self *ExecDNSProvider) run(ctx context.Context, action string, fqdn string, value string) error {
+	output, err := exec.CommandContext(ctx, self.command, action, fqdn, value).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s %s failed: %v: %s", self.command, action, err, bytes.TrimSpace(output))
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package tls_helper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var ErrFingerprintMismatch = errors.New("certificate doesn't match the pinned fingerprint")
+
+// ServerOptions configures TLS on a server from files
+type ServerOptions struct {
+	CertFile string
+	KeyFile  string
+	// ClientCAFile is optional, when set clients must present a certificate signed by one of its CAs
+	ClientCAFile string
+}
+
+// NewServerConfig loads the certificate and key in options, requiring client certificates when a client CA
+// is given
+func NewServerConfig(options ServerOptions) (*tls.Config, error) {
+	certificate, err := tls.LoadX509KeyPair(options.CertFile, options.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load certificate: %v", err)
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if options.ClientCAFile != "" {
+		if err := RequireClientCertificates(config, options.ClientCAFile); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// RequireClientCertificates makes config only accept clients presenting a certificate signed by one of the
+// CAs in caFile
+func RequireClientCertificates(config *tls.Config, caFile string) error {
+	pool, err := LoadCertPool(caFile)
+	if err != nil {
+		return err
+	}
+	config.ClientCAs = pool
+	config.ClientAuth = tls.RequireAndVerifyClientCert
+	return nil
+}
+
+// LoadCertPool reads the PEM encoded certificates in file into a pool
+func LoadCertPool(file string) (*x509.CertPool, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read CA file: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("no certificates found in CA file %s", file)
+	}
+	return pool, nil
+}
+
+// Fingerprint returns the SHA-256 fingerprint of cert in lower case hex
+func Fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// ParseFingerprint parses a SHA-256 fingerprint in hex, colons and upper case as printed by
+// "openssl x509 -fingerprint -sha256" are accepted
+func ParseFingerprint(s string) ([]byte, error) {
+	fingerprint, err := hex.DecodeString(strings.ReplaceAll(s, ":", ""))
+	if err != nil || len(fingerprint) != sha256.Size {
+		return nil, fmt.Errorf("invalid fingerprint %q, expecting a SHA-256 hash in hex", s)
+	}
+	return fingerprint, nil
+}
+
+// VerifyFingerprint returns a function for tls.Config's VerifyConnection that only accepts connections where
+// one of the certificates presented has fingerprint. Pinning the CA's fingerprint allows the server's
+// certificate to be renewed without updating every agent.
+func VerifyFingerprint(fingerprint []byte) func(tls.ConnectionState) error {
+	return func(state tls.ConnectionState) error {
+		for _, cert := range state.PeerCertificates {
+			sum := sha256.Sum256(cert.Raw)
+			if bytes.Equal(sum[:], fingerprint) {
+				return nil
+			}
+		}
+		return ErrFingerprintMismatch
+	}
+}
+
+// ClientOptions configures how a client verifies the server and the certificate it authenticates with
+type ClientOptions struct {
+	// CAFile holds the CAs the server's certificate must be signed by, the system's CAs are used when empty
+	CAFile string
+	// Fingerprint pins a certificate the server must present. When it's set without a CA file only the
+	// fingerprint is checked, which is how servers with a self-signed certificate are trusted.
+	Fingerprint string
+	// CertFile and KeyFile are the certificate presented to servers that require mutual TLS
+	CertFile string
+	KeyFile  string
+}
+
+// NewClientConfig builds the config for connecting to a server with options
+func NewClientConfig(options ClientOptions) (*tls.Config, error) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if options.CAFile != "" {
+		pool, err := LoadCertPool(options.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = pool
+	}
+	if options.Fingerprint != "" {
+		fingerprint, err := ParseFingerprint(options.Fingerprint)
+		if err != nil {
+			return nil, err
+		}
+		// VerifyConnection is still called when the chain isn't verified
+		config.InsecureSkipVerify = options.CAFile == ""
+		config.VerifyConnection = VerifyFingerprint(fingerprint)
+	}
+	if options.CertFile != "" || options.KeyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(options.CertFile, options.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %v", err)
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+	return config, nil
+}
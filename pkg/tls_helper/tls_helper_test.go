@@ -0,0 +1,148 @@
+package tls_helper
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveTls starts a TLS server on loopback with config, it completes the handshake with every
+// connection and then closes it
+func serveTls(t *testing.T, config *tls.Config) string {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", config)
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func writeFile(t *testing.T, name string, b []byte) string {
+	file := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(file, b, 0600))
+	return file
+}
+
+func TestParseFingerprint(t *testing.T) {
+	ca, err := NewCA("yuka test")
+	require.NoError(t, err)
+	fingerprint := Fingerprint(ca.Certificate)
+	assert.Len(t, fingerprint, 64)
+
+	b, err := ParseFingerprint(fingerprint)
+	require.NoError(t, err)
+	// As printed by openssl
+	var pairs []string
+	for i := 0; i < len(fingerprint); i += 2 {
+		pairs = append(pairs, strings.ToUpper(fingerprint[i:i+2]))
+	}
+	openssl, err := ParseFingerprint(strings.Join(pairs, ":"))
+	require.NoError(t, err)
+	assert.Equal(t, b, openssl)
+
+	for _, invalid := range []string{"", "abc", fingerprint[:62], fingerprint + "00", strings.Repeat("zz", 32)} {
+		_, err := ParseFingerprint(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestNewClientConfigVerifiesServer(t *testing.T) {
+	ca, err := NewCA("yuka test")
+	require.NoError(t, err)
+	certificate, err := ca.IssueServerCertificate("localhost", "127.0.0.1")
+	require.NoError(t, err)
+	address := serveTls(t, &tls.Config{Certificates: []tls.Certificate{certificate}})
+
+	otherCA, err := NewCA("someone else")
+	require.NoError(t, err)
+	caFile := writeFile(t, "ca.pem", ca.CertificatePEM())
+	otherCAFile := writeFile(t, "other-ca.pem", otherCA.CertificatePEM())
+
+	for _, tc := range []struct {
+		name    string
+		options ClientOptions
+		err     string
+	}{
+		{name: "ca", options: ClientOptions{CAFile: caFile}},
+		{name: "system cas", options: ClientOptions{}, err: "certificate signed by unknown authority"},
+		{name: "other ca", options: ClientOptions{CAFile: otherCAFile}, err: "certificate signed by unknown authority"},
+		{name: "pinned ca", options: ClientOptions{Fingerprint: Fingerprint(ca.Certificate)}},
+		{name: "pinned leaf", options: ClientOptions{Fingerprint: Fingerprint(certificate.Leaf)}},
+		{name: "ca and pinned", options: ClientOptions{CAFile: caFile, Fingerprint: Fingerprint(ca.Certificate)}},
+		{name: "pinned other", options: ClientOptions{Fingerprint: Fingerprint(otherCA.Certificate)}, err: ErrFingerprintMismatch.Error()},
+		{name: "ca and pinned other", options: ClientOptions{CAFile: caFile, Fingerprint: Fingerprint(otherCA.Certificate)}, err: ErrFingerprintMismatch.Error()},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := NewClientConfig(tc.options)
+			require.NoError(t, err)
+			conn, err := tls.Dial("tcp", address, config)
+			if tc.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+				return
+			}
+			require.NoError(t, err)
+			conn.Close()
+		})
+	}
+}
+
+func TestMutualTls(t *testing.T) {
+	ca, err := NewCA("yuka test")
+	require.NoError(t, err)
+	serverCertificate, err := ca.IssueServerCertificate("127.0.0.1")
+	require.NoError(t, err)
+	serverConfig := &tls.Config{Certificates: []tls.Certificate{serverCertificate}}
+	require.NoError(t, RequireClientCertificates(serverConfig, writeFile(t, "ca.pem", ca.CertificatePEM())))
+	address := serveTls(t, serverConfig)
+
+	clientCertificate, err := ca.IssueClientCertificate("agent")
+	require.NoError(t, err)
+	certFile, keyFile := writeKeyPair(t, clientCertificate)
+	caFile := writeFile(t, "ca.pem", ca.CertificatePEM())
+
+	// The server's rejection of the client only shows up when reading with TLS 1.3
+	handshake := func(options ClientOptions) error {
+		config, err := NewClientConfig(options)
+		require.NoError(t, err)
+		conn, err := tls.Dial("tcp", address, config)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		_, err = conn.Read(make([]byte, 1))
+		return err
+	}
+
+	err = handshake(ClientOptions{CAFile: caFile, CertFile: certFile, KeyFile: keyFile})
+	assert.ErrorContains(t, err, "EOF")
+	err = handshake(ClientOptions{CAFile: caFile})
+	assert.ErrorContains(t, err, "certificate required")
+}
+
+// writeKeyPair writes certificate and its key as PEM files as read by tls.LoadX509KeyPair
+func writeKeyPair(t *testing.T, certificate tls.Certificate) (string, string) {
+	key, err := x509.MarshalPKCS8PrivateKey(certificate.PrivateKey)
+	require.NoError(t, err)
+	var certPEM []byte
+	for _, der := range certificate.Certificate {
+		certPEM = append(certPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key})
+	return writeFile(t, "cert.pem", certPEM), writeFile(t, "key.pem", keyPEM)
+}
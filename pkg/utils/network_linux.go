@@ -4,9 +4,8 @@ package utils
 
 import (
 	"fmt"
-	"net"
+	"strings"
 
-	"github.com/vishvananda/netlink"
 	"go.uber.org/zap"
 )
 
@@ -18,52 +17,37 @@ func DefaultTunnelDevOS() string {
 	return defaultTunnelInterface
 }
 
+// AddRoute adds a route for the prefix pointing to the linux device
 func AddRoute(prefix, dev string) error {
-	link, err := netlink.LinkByName(dev)
-	if err != nil {
-		return fmt.Errorf("failed to lookup netlink device %s: %w", dev, err)
-	}
-
-	destNet, err := ParseIPNet(prefix)
-	if err != nil {
+	if _, err := ParseIPNet(prefix); err != nil {
 		return fmt.Errorf("failed to parse a valid network address from %s: %w", prefix, err)
 	}
 
-	return route(destNet, link)
-}
-
-// AddRoute adds a netlink route pointing to the linux device
-func route(ipNet *net.IPNet, dev netlink.Link) error {
-	return netlink.RouteAdd(&netlink.Route{
-		LinkIndex: dev.Attrs().Index,
-		Scope:     netlink.SCOPE_UNIVERSE,
-		Dst:       ipNet,
-	})
+	if _, err := RunCommand("ip", ipFamilyFlag(prefix), "route", "add", prefix, "dev", dev); err != nil {
+		return fmt.Errorf("route add failed: %w", err)
+	}
+	return nil
 }
 
-// RouteExistsOS checks netlink routes for the destination prefix
+// RouteExistsOS checks the routing table for the destination prefix
 func RouteExistsOS(prefix string) (bool, error) {
-	destNet, err := ParseIPNet(prefix)
-	if err != nil {
+	if _, err := ParseIPNet(prefix); err != nil {
 		return false, fmt.Errorf("failed to parse a valid network address from %s: %w", prefix, err)
 	}
 
-	destRoute := &netlink.Route{Dst: destNet}
-	family := netlink.FAMILY_V6
-	if destNet.IP.To4() != nil {
-		family = netlink.FAMILY_V4
-	}
-
-	match, err := netlink.RouteListFiltered(family, destRoute, netlink.RT_FILTER_DST)
+	output, err := RunCommand("ip", ipFamilyFlag(prefix), "route", "show", "exact", prefix)
 	if err != nil {
-		return true, fmt.Errorf("error retrieving netlink routes: %w", err)
+		return true, fmt.Errorf("error retrieving routes: %w", err)
 	}
+	return strings.TrimSpace(output) != "", nil
+}
 
-	if len(match) > 0 {
-		return true, nil
+// ipFamilyFlag returns the flag selecting the address family of prefix for the ip command
+func ipFamilyFlag(prefix string) string {
+	if destNet, _ := ParseIPNet(prefix); destNet != nil && destNet.IP.To4() == nil {
+		return "-6"
 	}
-
-	return false, nil
+	return "-4"
 }
 
 func DeleteInterface(logger *zap.SugaredLogger, networkInterface string) error {
@@ -10,6 +10,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -154,6 +155,26 @@ func IsYaml(s string) bool {
 	return ext == ".yaml" || ext == ".yml"
 }
 
+// HostnameRegex matches lower cased DNS names, i.e "foo" or "foo.example.com"
+var HostnameRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*$`)
+
+// IsSubdomain returns true if s is a lower cased DNS name that can be requested for a tunnel, i.e "foo"
+func IsSubdomain(s string) bool {
+	return len(s) <= 253 && HostnameRegex.MatchString(s)
+}
+
+// IsAddress returns true if s is a port or a host:port address, i.e "3000", ":3000" or "localhost:3000"
+func IsAddress(s string) bool {
+	host, port, err := net.SplitHostPort(s)
+	if err != nil {
+		host, port = "", s
+	}
+	if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+		return false
+	}
+	return host == "" || net.ParseIP(host) != nil || IsSubdomain(strings.ToLower(host))
+}
+
 // RunCommand runs the cmd and returns the combined stdout and stderr
 func RunCommand(cmd ...string) (string, error) {
 	output, err := exec.Command(cmd[0], cmd[1:]...).CombinedOutput()
@@ -230,6 +230,38 @@ func TestIsYamlFn(t *testing.T) {
 	assert.False(t, utils.IsYaml("test.json"))
 }
 
+func TestIsAddressFn(t *testing.T) {
+	for _, address := range []string{"3000", ":3000", "localhost:3000", "127.0.0.1:80", "[::1]:8080", "api.internal:65535"} {
+		assert.True(t, utils.IsAddress(address), address)
+	}
+	for _, address := range []string{"", "localhost", "localhost:", "0", "65536", "localhost:http", "foo_bar:80", "http://localhost:3000"} {
+		assert.False(t, utils.IsAddress(address), address)
+	}
+}
+
+func TestIsSubdomainFn(t *testing.T) {
+	for _, subdomain := range []string{"foo", "foo-bar", "foo.bar", "a1"} {
+		assert.True(t, utils.IsSubdomain(subdomain), subdomain)
+	}
+	for _, subdomain := range []string{"", "Foo", "-foo", "foo-", "foo..bar", "foo_bar", "foo bar", strings.Repeat("a", 64)} {
+		assert.False(t, utils.IsSubdomain(subdomain), subdomain)
+	}
+}
+
+func TestCoreValidatorAddressAndSubdomain(t *testing.T) {
+	type tunnel struct {
+		Addr      string `validate:"isAddress"`
+		Subdomain string `validate:"omitempty,isSubdomain"`
+	}
+	cv, err := utils.NewCoreValidator()
+	assert.Nil(t, err)
+
+	assert.Nil(t, cv.Validate(&tunnel{Addr: "localhost:3000", Subdomain: "web"}))
+	assert.Nil(t, cv.Validate(&tunnel{Addr: "3000"}))
+	assert.Error(t, cv.Validate(&tunnel{Addr: "localhost"}))
+	assert.Error(t, cv.Validate(&tunnel{Addr: "3000", Subdomain: "Web_1"}))
+}
+
 // ParseToIpv4Port tests
 func TestInvalidIpPort(t *testing.T) {
 	ipv4, err := utils.ParseToIpv4Port("asdojoasdj")
@@ -20,7 +20,9 @@ func NewCoreValidator() (*CoreValidator, error) {
 		validate: validator.New(),
 	}
 	if err := cv.RegisterValidators(map[string]func(level validator.FieldLevel) bool{
-		"isYaml": cv.isYaml,
+		"isYaml":      cv.isYaml,
+		"isAddress":   cv.isAddress,
+		"isSubdomain": cv.isSubdomain,
 	}); err != nil {
 		return nil, err
 	}
@@ -70,6 +72,16 @@ func (v *CoreValidator) isYaml(fl validator.FieldLevel) bool {
 	return ext == ".yaml" || ext == ".yml"
 }
 
+// isAddress validates the field is a port or a host:port address
+func (v *CoreValidator) isAddress(fl validator.FieldLevel) bool {
+	return IsAddress(fl.Field().String())
+}
+
+// isSubdomain validates the field is a lower cased DNS name
+func (v *CoreValidator) isSubdomain(fl validator.FieldLevel) bool {
+	return IsSubdomain(fl.Field().String())
+}
+
 // validationError converts a validator error into a more friendly error message
 func (v *CoreValidator) validationError(err error) error {
 	var ve validator.ValidationErrors